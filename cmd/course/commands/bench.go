@@ -0,0 +1,243 @@
+package commands
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/imrenagicom/demo-app/course/booking"
+	"github.com/imrenagicom/demo-app/course/catalog"
+	"github.com/imrenagicom/demo-app/course/customer"
+	"github.com/imrenagicom/demo-app/internal/audit"
+	"github.com/imrenagicom/demo-app/internal/instrumentation"
+	"github.com/imrenagicom/demo-app/internal/postgres"
+	"github.com/imrenagicom/demo-app/internal/redis"
+	"github.com/imrenagicom/demo-app/internal/util"
+	v1 "github.com/imrenagicom/demo-app/pkg/apiclient/course/v1"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+func newBench(opts *opts) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "bench",
+		Short: "benchmark subcommands. These commands write data to the configured database",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+		},
+	}
+	command.AddCommand(
+		newBenchReserve(opts),
+		newBenchPreload(opts),
+	)
+	return command
+}
+
+type benchReserveOpts struct {
+	envPrefix   string
+	strategies  []string
+	concurrency int
+	bookings    int
+	seats       int
+}
+
+func newBenchReserve(opts *opts) *cobra.Command {
+	benchOpts := &benchReserveOpts{}
+	command := &cobra.Command{
+		Use:   "reserve",
+		Short: "compare seat reservation strategies under concurrent reservations of a single batch",
+		RunE: func(c *cobra.Command, args []string) error {
+			conf, err := loadConfig(opts, benchOpts.envPrefix)
+			if err != nil {
+				log.Fatal().Err(err).Msg("unable to load config file")
+			}
+			logFn := instrumentation.InitializeLogger(conf.Log)
+			defer logFn()
+
+			var strategies []booking.ReservationStrategy
+			for _, s := range benchOpts.strategies {
+				strategy, err := booking.ParseReservationStrategy(s)
+				if err != nil {
+					return err
+				}
+				strategies = append(strategies, strategy)
+			}
+
+			db, err := postgres.Connect(c.Context(), conf.DB)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			rdb, err := redis.Connect(c.Context(), conf.Redis)
+			if err != nil {
+				return err
+			}
+			defer rdb.Close()
+			clients := &util.Clients{
+				DB:    db,
+				Redis: rdb,
+			}
+			catalogStore := catalog.NewStore(clients.DB, clients.Redis)
+			bookingStore := booking.NewStore(clients.DB, clients.Redis)
+			customerSvc := customer.NewService(clients.DB, customer.NewStore(clients.DB, clients.Redis), audit.NewStore(clients.DB))
+
+			ctx := c.Context()
+			var results []reserveBenchResult
+			for _, strategy := range strategies {
+				svc := booking.NewService(clients.DB, bookingStore, catalogStore, customerSvc,
+					booking.WithReservationStrategy(strategy))
+				res, err := runReserveBench(ctx, benchOpts, strategy, svc, catalogStore, bookingStore)
+				if err != nil {
+					return fmt.Errorf("benchmark %s: %w", strategy, err)
+				}
+				results = append(results, res)
+			}
+			printReserveBench(results, benchOpts)
+			return nil
+		},
+	}
+	command.Flags().StringVar(&benchOpts.envPrefix, "env-prefix", "COURSE_SERVER", "config prefix")
+	command.Flags().StringSliceVar(&benchOpts.strategies, "strategies", []string{
+		string(booking.ReservationOptimistic),
+		string(booking.ReservationRowLock),
+		string(booking.ReservationAtomic),
+		string(booking.ReservationRedis),
+	}, "reservation strategies to compare")
+	command.Flags().IntVar(&benchOpts.concurrency, "concurrency", 50, "number of concurrent reservations")
+	command.Flags().IntVar(&benchOpts.bookings, "bookings", 500, "number of bookings competing for the batch")
+	command.Flags().IntVar(&benchOpts.seats, "seats", 100, "number of seats of the batch")
+	return command
+}
+
+type reserveBenchResult struct {
+	strategy      booking.ReservationStrategy
+	duration      time.Duration
+	latencies     []time.Duration
+	reserved      int
+	soldOut       int
+	retryExceeded int
+	failed        int
+	seatsLeft     int32
+}
+
+func (r reserveBenchResult) percentile(p float64) time.Duration {
+	if len(r.latencies) == 0 {
+		return 0
+	}
+	idx := int(float64(len(r.latencies)-1) * p)
+	return r.latencies[idx]
+}
+
+func runReserveBench(ctx context.Context,
+	o *benchReserveOpts,
+	strategy booking.ReservationStrategy,
+	svc *booking.Service,
+	catalogStore *catalog.Store,
+	bookingStore *booking.Store,
+) (reserveBenchResult, error) {
+	now := time.Now()
+	batch := catalog.Batch{
+		ID:             uuid.New(),
+		CreatedAt:      now,
+		UpdatedAt:      now,
+		Name:           fmt.Sprintf("bench %s", strategy),
+		MaxSeats:       int32(o.seats),
+		AvailableSeats: int32(o.seats),
+		Price:          100000,
+		Currency:       "IDR",
+		Status:         catalog.BatchStatusPublished,
+		StartDate:      sql.NullTime{Time: now, Valid: true},
+		EndDate:        sql.NullTime{Time: now.AddDate(0, 1, 0), Valid: true},
+	}
+	// the course is kept as draft so that it does not show up in the catalog.
+	course := catalog.Course{
+		ID:        uuid.New(),
+		CreatedAt: now,
+		UpdatedAt: now,
+		Name:      fmt.Sprintf("bench %s", strategy),
+		Slug:      fmt.Sprintf("bench-%s-%d", strategy, now.UnixNano()),
+		Status:    catalog.CourseStatusDraft,
+		Batches:   []catalog.Batch{batch},
+	}
+	if err := catalogStore.CreateCourse(ctx, &course); err != nil {
+		return reserveBenchResult{}, err
+	}
+
+	ids := make(chan string, o.bookings)
+	for i := 0; i < o.bookings; i++ {
+		b := booking.For(&course, &batch).Build()
+		if err := bookingStore.CreateBooking(ctx, b); err != nil {
+			return reserveBenchResult{}, err
+		}
+		ids <- b.ID.String()
+	}
+	close(ids)
+
+	res := reserveBenchResult{strategy: strategy}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	start := time.Now()
+	for i := 0; i < o.concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range ids {
+				t := time.Now()
+				_, err := svc.ReserveBooking(ctx, &v1.ReserveBookingRequest{Booking: id})
+				latency := time.Since(t)
+
+				mu.Lock()
+				res.latencies = append(res.latencies, latency)
+				switch {
+				case err == nil:
+					res.reserved++
+				case errors.Is(err, catalog.ErrNotEnoughSeats),
+					errors.Is(err, catalog.ErrClassSoldOut),
+					errors.Is(err, catalog.ErrClassNotAvailableForSale):
+					res.soldOut++
+				case errors.Is(err, booking.ErrReservationMaxRetryExceeded):
+					res.retryExceeded++
+				default:
+					res.failed++
+					log.Debug().Err(err).Str("booking", id).Msg("reservation failed")
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	res.duration = time.Since(start)
+	sort.Slice(res.latencies, func(i, j int) bool { return res.latencies[i] < res.latencies[j] })
+
+	b, err := catalogStore.FindCourseBatchByID(ctx, batch.ID.String())
+	if err != nil {
+		return reserveBenchResult{}, err
+	}
+	res.seatsLeft = b.AvailableSeats
+	return res, nil
+}
+
+func printReserveBench(results []reserveBenchResult, o *benchReserveOpts) {
+	fmt.Printf("%d bookings competing for %d seats with %d concurrent reservations\n\n", o.bookings, o.seats, o.concurrency)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "strategy\treserved\tsold out\tretry exceeded\tfailed\treq/s\tp50\tp95\tp99\tseats left\tconsistent\t")
+	for _, r := range results {
+		throughput := float64(len(r.latencies)) / r.duration.Seconds()
+		consistent := int(r.seatsLeft) == o.seats-r.reserved
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%.1f\t%s\t%s\t%s\t%d\t%t\t\n",
+			r.strategy, r.reserved, r.soldOut, r.retryExceeded, r.failed, throughput,
+			r.percentile(0.50).Round(time.Microsecond),
+			r.percentile(0.95).Round(time.Microsecond),
+			r.percentile(0.99).Round(time.Microsecond),
+			r.seatsLeft, consistent)
+	}
+	w.Flush()
+}
@@ -0,0 +1,184 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/imrenagicom/demo-app/course/catalog"
+	"github.com/imrenagicom/demo-app/internal/instrumentation"
+	"github.com/imrenagicom/demo-app/internal/postgres"
+	"github.com/imrenagicom/demo-app/internal/redis"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+type benchPreloadOpts struct {
+	envPrefix        string
+	iterations       int
+	pageSize         uint64
+	batchesPerCourse uint64
+}
+
+func newBenchPreload(opts *opts) *cobra.Command {
+	benchOpts := &benchPreloadOpts{}
+	command := &cobra.Command{
+		Use:   "preload",
+		Short: "compare loading batches per course against preloading them for the whole page. Requires seeded courses",
+		RunE: func(c *cobra.Command, args []string) error {
+			conf, err := loadConfig(opts, benchOpts.envPrefix)
+			if err != nil {
+				log.Fatal().Err(err).Msg("unable to load config file")
+			}
+			logFn := instrumentation.InitializeLogger(conf.Log)
+			defer logFn()
+
+			db, err := postgres.Connect(c.Context(), conf.DB)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			store := catalog.NewStore(db, redis.New(conf.Redis))
+			defer store.Clear()
+
+			ctx := c.Context()
+			var results []preloadBenchResult
+			for _, m := range preloadBenchModes(store, benchOpts) {
+				res, err := runPreloadBench(ctx, m, benchOpts.iterations)
+				if err != nil {
+					return fmt.Errorf("benchmark %s: %w", m.name, err)
+				}
+				results = append(results, res)
+			}
+			printPreloadBench(results, benchOpts)
+			return nil
+		},
+	}
+	command.Flags().StringVar(&benchOpts.envPrefix, "env-prefix", "COURSE_SERVER", "config prefix")
+	command.Flags().IntVar(&benchOpts.iterations, "iterations", 200, "number of pages loaded per mode")
+	command.Flags().Uint64Var(&benchOpts.pageSize, "page-size", 50, "number of courses per page")
+	command.Flags().Uint64Var(&benchOpts.batchesPerCourse, "batches-per-course", 10, "maximum number of batches loaded per course")
+	return command
+}
+
+type preloadBenchMode struct {
+	name string
+	// load loads one page of courses with their batches and returns the number of loaded batches.
+	load func(ctx context.Context) (int, error)
+}
+
+func preloadBenchModes(store *catalog.Store, o *benchPreloadOpts) []preloadBenchMode {
+	countBatches := func(courses []catalog.Course) int {
+		n := 0
+		for _, c := range courses {
+			n += len(c.Batches)
+		}
+		return n
+	}
+	return []preloadBenchMode{
+		{
+			// the previous implementation, one query per course of the page
+			name: "per-course",
+			load: func(ctx context.Context) (int, error) {
+				courses, _, err := store.FindAllCourse(ctx, catalog.WithMaxResults(o.pageSize))
+				if err != nil {
+					return 0, err
+				}
+				for i := range courses {
+					batches, _, err := store.FindAllBatchesByCourseID(ctx, courses[i].ID.String(),
+						catalog.WithMaxResults(o.batchesPerCourse))
+					if err != nil {
+						return 0, err
+					}
+					courses[i].Batches = batches
+				}
+				return countBatches(courses), nil
+			},
+		},
+		{
+			name: "lateral-join",
+			load: func(ctx context.Context) (int, error) {
+				courses, _, err := store.FindAllCourse(ctx,
+					catalog.WithMaxResults(o.pageSize),
+					catalog.WithPreload(),
+					catalog.WithBatchesPerCourse(o.batchesPerCourse))
+				return countBatches(courses), err
+			},
+		},
+		{
+			name: "lateral-join-masked",
+			load: func(ctx context.Context) (int, error) {
+				courses, _, err := store.FindAllCourse(ctx,
+					catalog.WithMaxResults(o.pageSize),
+					catalog.WithPreloadFields("price", "available_seats"),
+					catalog.WithBatchesPerCourse(o.batchesPerCourse))
+				return countBatches(courses), err
+			},
+		},
+	}
+}
+
+type preloadBenchResult struct {
+	mode      string
+	duration  time.Duration
+	latencies []time.Duration
+	batches   int
+}
+
+func (r preloadBenchResult) percentile(p float64) time.Duration {
+	if len(r.latencies) == 0 {
+		return 0
+	}
+	idx := int(float64(len(r.latencies)-1) * p)
+	return r.latencies[idx]
+}
+
+func runPreloadBench(ctx context.Context, m preloadBenchMode, iterations int) (preloadBenchResult, error) {
+	res := preloadBenchResult{mode: m.name}
+	// warm up the statement cache and the database buffers
+	if _, err := m.load(ctx); err != nil {
+		return res, err
+	}
+
+	start := time.Now()
+	for i := 0; i < iterations; i++ {
+		s := time.Now()
+		n, err := m.load(ctx)
+		if err != nil {
+			return res, err
+		}
+		res.latencies = append(res.latencies, time.Since(s))
+		res.batches = n
+	}
+	res.duration = time.Since(start)
+	sort.Slice(res.latencies, func(i, j int) bool { return res.latencies[i] < res.latencies[j] })
+	return res, nil
+}
+
+func printPreloadBench(results []preloadBenchResult, o *benchPreloadOpts) {
+	fmt.Printf("%d pages of %d courses with up to %d batches per course\n\n", o.iterations, o.pageSize, o.batchesPerCourse)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "mode\tbatches/page\tpages/s\tp50\tp95\tp99\tspeedup\t")
+	var baseline time.Duration
+	for i, r := range results {
+		p50 := r.percentile(0.50)
+		if i == 0 {
+			baseline = p50
+		}
+		speedup := 0.0
+		if p50 > 0 {
+			speedup = float64(baseline) / float64(p50)
+		}
+		fmt.Fprintf(w, "%s\t%d\t%.1f\t%s\t%s\t%s\t%.2fx\t\n",
+			r.mode, r.batches, float64(len(r.latencies))/r.duration.Seconds(),
+			p50.Round(time.Microsecond),
+			r.percentile(0.95).Round(time.Microsecond),
+			r.percentile(0.99).Round(time.Microsecond),
+			speedup)
+	}
+	w.Flush()
+}
@@ -0,0 +1,232 @@
+package commands
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/imrenagicom/demo-app/course/catalog"
+	"github.com/imrenagicom/demo-app/internal/instrumentation"
+	"github.com/imrenagicom/demo-app/internal/postgres"
+	"github.com/imrenagicom/demo-app/internal/redis"
+	v1 "github.com/imrenagicom/demo-app/pkg/apiclient/course/v1"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+var errImportFailed = errors.New("some records failed to import")
+
+func newCatalog(opts *opts) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "catalog",
+		Short: "bulk import and export of courses and batches",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+		},
+	}
+	command.AddCommand(
+		newCatalogImport(opts),
+		newCatalogExport(opts),
+	)
+	return command
+}
+
+type catalogImportOpts struct {
+	envPrefix string
+	format    string
+	dryRun    bool
+	chunkSize int
+	output    string
+}
+
+func newCatalogImport(opts *opts) *cobra.Command {
+	importOpts := &catalogImportOpts{}
+	command := &cobra.Command{
+		Use:   "import FILE",
+		Short: "upsert courses and their batches by slug from a csv, json or ndjson file",
+		Long: `Upsert courses and their batches by slug. Batches which are not listed in
+the file are left untouched. Use - as FILE to read from stdin.
+
+CSV files have one row per batch with the columns
+  course, course_name, description, course_status, batch, batch_name,
+  max_seats, price, currency, start_date, end_date, batch_status,
+  sales_open_at, sales_close_at
+Consecutive rows of the same course are merged into one course.
+
+By default the whole import runs in a single transaction which is rolled back
+when any record fails. With --chunk-size every chunk is committed on its own
+and only the failed records are skipped. --dry-run validates and applies the
+records, but rolls everything back.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			if importOpts.output != "text" && importOpts.output != "json" {
+				return fmt.Errorf("unsupported output %q", importOpts.output)
+			}
+			format, err := bulkFormat(importOpts.format, args[0])
+			if err != nil {
+				return err
+			}
+
+			in := io.Reader(os.Stdin)
+			if args[0] != "-" {
+				f, err := os.Open(args[0])
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				in = f
+			}
+
+			conf, err := loadConfig(opts, importOpts.envPrefix)
+			if err != nil {
+				log.Fatal().Err(err).Msg("unable to load config file")
+			}
+			logFn := instrumentation.InitializeLogger(conf.Log)
+			defer logFn()
+
+			var options []catalog.ImportOption
+			if importOpts.dryRun {
+				options = append(options, catalog.WithImportDryRun())
+			}
+			options = append(options, catalog.WithImportChunkSize(importOpts.chunkSize))
+
+			db, err := postgres.Connect(c.Context(), conf.DB)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			store := catalog.NewStore(db, redis.New(conf.Redis))
+			defer store.Clear()
+			report, err := store.ImportCourses(c.Context(), catalog.NewRecordReader(format, bufio.NewReader(in)), options...)
+			if err != nil {
+				return err
+			}
+
+			if importOpts.output == "json" {
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				if err := enc.Encode(report); err != nil {
+					return err
+				}
+			} else {
+				printImportReport(os.Stdout, report)
+			}
+
+			if report.Failed > 0 {
+				c.SilenceUsage = true
+				return errImportFailed
+			}
+			return nil
+		},
+	}
+	command.Flags().StringVar(&importOpts.envPrefix, "env-prefix", "COURSE_SERVER", "config prefix")
+	command.Flags().StringVarP(&importOpts.format, "format", "f", "", "file format, either csv, json or ndjson. Defaults to the file extension")
+	command.Flags().BoolVar(&importOpts.dryRun, "dry-run", false, "validate and apply the records, but roll everything back")
+	command.Flags().IntVar(&importOpts.chunkSize, "chunk-size", 0, "commit every n records instead of importing in a single transaction")
+	command.Flags().StringVarP(&importOpts.output, "output", "o", "text", "report format, either text or json")
+	return command
+}
+
+func printImportReport(out io.Writer, report *catalog.ImportReport) {
+	fmt.Fprintf(out, "%d records, %d failed\n", report.Total, report.Failed)
+	fmt.Fprintf(out, "courses: %d created, %d updated\n", report.CoursesCreated, report.CoursesUpdated)
+	fmt.Fprintf(out, "batches: %d created, %d updated\n", report.BatchesCreated, report.BatchesUpdated)
+
+	if len(report.Errors) > 0 {
+		fmt.Fprintln(out)
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "ROW\tSLUG\tERROR")
+		for _, e := range report.Errors {
+			fmt.Fprintf(w, "%d\t%s\t%s\n", e.Row, e.Slug, e.Message)
+		}
+		w.Flush()
+		if int64(len(report.Errors)) < report.Failed {
+			fmt.Fprintf(out, "... %d more errors\n", report.Failed-int64(len(report.Errors)))
+		}
+	}
+
+	switch {
+	case report.DryRun:
+		fmt.Fprintln(out, "\ndry run: no changes were committed")
+	case !report.Committed:
+		fmt.Fprintln(out, "\nno changes were committed")
+	}
+}
+
+type catalogExportOpts struct {
+	envPrefix string
+	format    string
+	status    string
+	file      string
+}
+
+func newCatalogExport(opts *opts) *cobra.Command {
+	exportOpts := &catalogExportOpts{}
+	command := &cobra.Command{
+		Use:   "export",
+		Short: "export courses and their batches as csv, json or ndjson",
+		RunE: func(c *cobra.Command, args []string) error {
+			format, err := bulkFormat(exportOpts.format, exportOpts.file)
+			if err != nil {
+				return err
+			}
+
+			out := io.Writer(os.Stdout)
+			if exportOpts.file != "" && exportOpts.file != "-" {
+				f, err := os.Create(exportOpts.file)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				out = f
+			}
+			buf := bufio.NewWriter(out)
+			defer buf.Flush()
+
+			conf, err := loadConfig(opts, exportOpts.envPrefix)
+			if err != nil {
+				log.Fatal().Err(err).Msg("unable to load config file")
+			}
+			logFn := instrumentation.InitializeLogger(conf.Log)
+			defer logFn()
+
+			db, err := postgres.Connect(c.Context(), conf.DB)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			store := catalog.NewStore(db, redis.New(conf.Redis))
+			defer store.Clear()
+
+			w := catalog.NewRecordWriter(format, buf)
+			if err := store.ExportCourses(c.Context(), exportOpts.status, func(rec *v1.CourseRecord) error {
+				return w.Write(rec)
+			}); err != nil {
+				return err
+			}
+			return w.Close()
+		},
+	}
+	command.Flags().StringVar(&exportOpts.envPrefix, "env-prefix", "COURSE_SERVER", "config prefix")
+	command.Flags().StringVarP(&exportOpts.format, "format", "f", "", "file format, either csv, json or ndjson. Defaults to the file extension, or ndjson")
+	command.Flags().StringVar(&exportOpts.status, "status", "", "only export courses in this status, either draft, published or archived")
+	command.Flags().StringVar(&exportOpts.file, "file", "", "file to write to. Defaults to stdout")
+	return command
+}
+
+// bulkFormat returns the format given by flag, or else the one matching the
+// extension of the file.
+func bulkFormat(flag, path string) (catalog.Format, error) {
+	if flag != "" {
+		return catalog.ParseFormat(flag)
+	}
+	if path == "" || path == "-" {
+		return catalog.FormatNDJSON, nil
+	}
+	return catalog.FormatFromPath(path)
+}
@@ -0,0 +1,81 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+type configOpts struct {
+	envPrefix string
+}
+
+func newConfig(opts *opts) *cobra.Command {
+	configOpts := &configOpts{}
+	command := &cobra.Command{
+		Use:   "config",
+		Short: "config subcommands",
+		Long: `The config is loaded from, in increasing precedence, the defaults, the
+--config file, environment variables and --set flags. Environment variables are
+named after the upper case key with the --env-prefix, such as
+COURSE_SERVER_DB_MAXOPENCONN for db.maxOpenConn.
+
+Secrets can be read from files with db.passwordFile, redis.passwordFile,
+pagination.cursorSecretFile, admin.tokenFile and auth.customerTokenSecretFile.`,
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+		},
+	}
+	command.AddCommand(
+		newConfigPrint(opts, configOpts),
+		newConfigValidate(opts, configOpts),
+	)
+	command.PersistentFlags().StringVar(&configOpts.envPrefix, "env-prefix", "COURSE_SERVER", "config prefix")
+	return command
+}
+
+func newConfigPrint(opts *opts, configOpts *configOpts) *cobra.Command {
+	var redacted bool
+	command := &cobra.Command{
+		Use:   "print",
+		Short: "print the effective config as yaml",
+		Args:  cobra.NoArgs,
+		RunE: func(c *cobra.Command, args []string) error {
+			conf, err := loadConfig(opts, configOpts.envPrefix)
+			if err != nil {
+				c.SilenceUsage = true
+				return err
+			}
+			if redacted {
+				conf = conf.Redacted()
+			}
+
+			enc := yaml.NewEncoder(os.Stdout)
+			enc.SetIndent(2)
+			if err := enc.Encode(conf); err != nil {
+				return err
+			}
+			return enc.Close()
+		},
+	}
+	command.Flags().BoolVar(&redacted, "redacted", false, "replace passwords and secrets")
+	return command
+}
+
+func newConfigValidate(opts *opts, configOpts *configOpts) *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate",
+		Short: "check that the config is valid",
+		Args:  cobra.NoArgs,
+		RunE: func(c *cobra.Command, args []string) error {
+			if _, err := loadConfig(opts, configOpts.envPrefix); err != nil {
+				c.SilenceUsage = true
+				return err
+			}
+			fmt.Println("config is valid")
+			return nil
+		},
+	}
+}
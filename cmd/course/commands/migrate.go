@@ -0,0 +1,249 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/imrenagicom/demo-app/internal/instrumentation"
+	"github.com/imrenagicom/demo-app/internal/postgres"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+var errDirtyDatabase = errors.New("database is dirty")
+
+type migrateOpts struct {
+	envPrefix   string
+	lockTimeout time.Duration
+}
+
+func newMigrate(opts *opts) *cobra.Command {
+	migrateOpts := &migrateOpts{}
+	command := &cobra.Command{
+		Use:   "migrate",
+		Short: "manage database migrations",
+		Long: `Manage the database migrations of the --migration directory.
+
+Every command changing the schema holds a postgres advisory lock, so servers
+migrating on start and these commands never run migrations concurrently.
+
+When a migration fails halfway the database is marked dirty at its version and
+no migration runs until the schema is fixed by hand and the version is set
+with force.`,
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+		},
+	}
+	command.AddCommand(
+		newMigrateUp(opts, migrateOpts),
+		newMigrateDown(opts, migrateOpts),
+		newMigrateGoto(opts, migrateOpts),
+		newMigrateForce(opts, migrateOpts),
+		newMigrateStatus(opts, migrateOpts),
+		newMigrateCreate(opts),
+	)
+	command.PersistentFlags().StringVar(&migrateOpts.envPrefix, "env-prefix", "COURSE_SERVER", "config prefix")
+	command.PersistentFlags().DurationVar(&migrateOpts.lockTimeout, "lock-timeout", postgres.DefaultMigrationLockTimeout, "how long to wait for another migration to finish")
+	return command
+}
+
+// runMigrator loads the config and runs fn with a migrator of the migration directory.
+func runMigrator(opts *opts, migrateOpts *migrateOpts, fn func(m *postgres.Migrator) error) error {
+	conf, err := loadConfig(opts, migrateOpts.envPrefix)
+	if err != nil {
+		log.Fatal().Err(err).Msg("unable to load config file")
+	}
+	logFn := instrumentation.InitializeLogger(conf.Log)
+	defer logFn()
+
+	m, err := postgres.NewMigrator(opts.migrationDir, conf.DB.DatabaseUrl(),
+		postgres.WithMigrationLockTimeout(migrateOpts.lockTimeout))
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+	return fn(m)
+}
+
+func newMigrateUp(opts *opts, migrateOpts *migrateOpts) *cobra.Command {
+	return &cobra.Command{
+		Use:   "up",
+		Short: "apply all pending migrations",
+		Args:  cobra.NoArgs,
+		RunE: func(c *cobra.Command, args []string) error {
+			return runMigrator(opts, migrateOpts, func(m *postgres.Migrator) error {
+				if err := m.Up(c.Context()); err != nil {
+					return err
+				}
+				return printMigrationVersion(c, m)
+			})
+		},
+	}
+}
+
+func newMigrateDown(opts *opts, migrateOpts *migrateOpts) *cobra.Command {
+	var all bool
+	command := &cobra.Command{
+		Use:   "down [N]",
+		Short: "revert the last N migrations, 1 by default",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			steps := 1
+			if len(args) > 0 {
+				n, err := strconv.Atoi(args[0])
+				if err != nil || n < 1 {
+					return fmt.Errorf("invalid number of migrations %q", args[0])
+				}
+				steps = n
+			}
+			if all {
+				steps = 0
+			}
+			return runMigrator(opts, migrateOpts, func(m *postgres.Migrator) error {
+				if err := m.Down(c.Context(), steps); err != nil {
+					return err
+				}
+				return printMigrationVersion(c, m)
+			})
+		},
+	}
+	command.Flags().BoolVar(&all, "all", false, "revert all migrations")
+	return command
+}
+
+func newMigrateGoto(opts *opts, migrateOpts *migrateOpts) *cobra.Command {
+	return &cobra.Command{
+		Use:   "goto VERSION",
+		Short: "migrate up or down to the given version",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			version, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid version %q", args[0])
+			}
+			return runMigrator(opts, migrateOpts, func(m *postgres.Migrator) error {
+				if err := m.Goto(c.Context(), uint(version)); err != nil {
+					return err
+				}
+				return printMigrationVersion(c, m)
+			})
+		},
+	}
+}
+
+func newMigrateForce(opts *opts, migrateOpts *migrateOpts) *cobra.Command {
+	return &cobra.Command{
+		Use:   "force VERSION",
+		Short: "set the version and clear the dirty state without running any migration",
+		Long: `Set the version and clear the dirty state without running any migration.
+
+Use it after fixing the schema of a dirty database by hand: force the dirty
+version when its migration is now applied completely, or the previous version
+when it is reverted completely. A version of -1 means no migration is applied.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			version, err := strconv.Atoi(args[0])
+			if err != nil || version < -1 {
+				return fmt.Errorf("invalid version %q", args[0])
+			}
+			return runMigrator(opts, migrateOpts, func(m *postgres.Migrator) error {
+				if err := m.Force(c.Context(), version); err != nil {
+					return err
+				}
+				return printMigrationVersion(c, m)
+			})
+		},
+	}
+}
+
+func newMigrateStatus(opts *opts, migrateOpts *migrateOpts) *cobra.Command {
+	var output string
+	command := &cobra.Command{
+		Use:   "status",
+		Short: "show the current version and the pending migrations",
+		Long: `Show the current version and the pending migrations. It exits with an
+error when the database is dirty.`,
+		Args: cobra.NoArgs,
+		RunE: func(c *cobra.Command, args []string) error {
+			if output != "text" && output != "json" {
+				return fmt.Errorf("unsupported output %q", output)
+			}
+			return runMigrator(opts, migrateOpts, func(m *postgres.Migrator) error {
+				status, err := m.Status(c.Context())
+				if err != nil {
+					return err
+				}
+				if output == "json" {
+					enc := json.NewEncoder(os.Stdout)
+					enc.SetIndent("", "  ")
+					if err := enc.Encode(status); err != nil {
+						return err
+					}
+				} else {
+					printMigrationStatus(os.Stdout, status)
+				}
+				if status.Dirty {
+					c.SilenceUsage = true
+					return errDirtyDatabase
+				}
+				return nil
+			})
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "text", "output format, either text or json")
+	return command
+}
+
+func newMigrateCreate(opts *opts) *cobra.Command {
+	return &cobra.Command{
+		Use:   "create NAME",
+		Short: "create empty up and down files of a new migration",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			paths, err := postgres.CreateMigration(opts.migrationDir, args[0])
+			for _, p := range paths {
+				fmt.Println(p)
+			}
+			return err
+		},
+	}
+}
+
+func printMigrationVersion(c *cobra.Command, m *postgres.Migrator) error {
+	status, err := m.Status(c.Context())
+	if err != nil {
+		return err
+	}
+	fmt.Printf("version %d, %d pending\n", status.Version, status.Pending())
+	return nil
+}
+
+func printMigrationStatus(out io.Writer, status *postgres.MigrationStatus) {
+	if status.Dirty {
+		fmt.Fprintf(out, "version %d (DIRTY), latest %d\n", status.Version, status.Latest)
+		fmt.Fprintf(out, "%s\n\n", postgres.ErrDirty{Version: status.Version})
+	} else {
+		fmt.Fprintf(out, "version %d, latest %d, %d pending\n\n", status.Version, status.Latest, status.Pending())
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tSTATE")
+	for _, mg := range status.Migrations {
+		state := "pending"
+		switch {
+		case status.Dirty && mg.Version == status.Version:
+			state = "dirty"
+		case mg.Applied:
+			state = "applied"
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\n", mg.Version, mg.Name, state)
+	}
+	w.Flush()
+}
@@ -0,0 +1,123 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/imrenagicom/demo-app/course/catalog"
+	"github.com/imrenagicom/demo-app/course/inventory"
+	"github.com/imrenagicom/demo-app/internal/instrumentation"
+	"github.com/imrenagicom/demo-app/internal/postgres"
+	"github.com/imrenagicom/demo-app/internal/redis"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+var errDriftDetected = errors.New("seat inventory drift detected")
+
+type reconcileOpts struct {
+	envPrefix   string
+	fix         bool
+	dryRun      bool
+	output      string
+	batches     []string
+	failOnDrift bool
+}
+
+func newReconcile(opts *opts) *cobra.Command {
+	reconcileOpts := &reconcileOpts{}
+	command := &cobra.Command{
+		Use:   "reconcile",
+		Short: "check that available seats of every batch match its active bookings",
+		Long: `Compute the expected available seats of every batch with limited seats from
+its reserved and completed bookings and report the batches that drifted.
+
+With --fix the drifted batches are corrected in a single transaction. Adding
+--dry-run runs the same transaction but rolls it back at the end.`,
+		RunE: func(c *cobra.Command, args []string) error {
+			if reconcileOpts.output != "text" && reconcileOpts.output != "json" {
+				return fmt.Errorf("unsupported output %q", reconcileOpts.output)
+			}
+			conf, err := loadConfig(opts, reconcileOpts.envPrefix)
+			if err != nil {
+				log.Fatal().Err(err).Msg("unable to load config file")
+			}
+			logFn := instrumentation.InitializeLogger(conf.Log)
+			defer logFn()
+
+			var options []inventory.Option
+			if reconcileOpts.fix {
+				options = append(options, inventory.WithFix())
+			}
+			if reconcileOpts.dryRun {
+				options = append(options, inventory.WithDryRun())
+			}
+			if len(reconcileOpts.batches) > 0 {
+				options = append(options, inventory.WithBatches(reconcileOpts.batches...))
+			}
+
+			db, err := postgres.Connect(c.Context(), conf.DB)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			// the seat counters of the fixed batches are corrected in redis as
+			// well, when it is reachable.
+			cache := catalog.NewStore(db, redis.New(conf.Redis))
+			reconciler := inventory.NewReconciler(db, inventory.WithSeatCache(cache))
+			report, err := reconciler.Reconcile(c.Context(), options...)
+			if err != nil {
+				return err
+			}
+
+			if reconcileOpts.output == "json" {
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				if err := enc.Encode(report); err != nil {
+					return err
+				}
+			} else {
+				printReconcileReport(os.Stdout, report)
+			}
+
+			if reconcileOpts.failOnDrift && report.HasDrift() {
+				c.SilenceUsage = true
+				return errDriftDetected
+			}
+			return nil
+		},
+	}
+	command.Flags().StringVar(&reconcileOpts.envPrefix, "env-prefix", "COURSE_SERVER", "config prefix")
+	command.Flags().BoolVar(&reconcileOpts.fix, "fix", false, "correct available seats of the drifted batches")
+	command.Flags().BoolVar(&reconcileOpts.dryRun, "dry-run", false, "roll back the fix instead of committing it")
+	command.Flags().StringVarP(&reconcileOpts.output, "output", "o", "text", "output format, either text or json")
+	command.Flags().StringSliceVar(&reconcileOpts.batches, "batch", nil, "only check the given batch ids")
+	command.Flags().BoolVar(&reconcileOpts.failOnDrift, "fail-on-drift", false, "exit with non zero code when drift is detected")
+	return command
+}
+
+func printReconcileReport(out io.Writer, report *inventory.Report) {
+	fmt.Fprintf(out, "checked %d batches, %d drifted\n", report.BatchesChecked, len(report.Drifts))
+	if !report.HasDrift() {
+		return
+	}
+
+	fmt.Fprintln(out)
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "BATCH\tCOURSE\tMAX\tAVAILABLE\tACTIVE\tEXPECTED\tDELTA\tOVERSOLD\tFIXED")
+	for _, d := range report.Drifts {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%d\t%+d\t%t\t%t\n",
+			d.BatchID, d.CourseID, d.MaxSeats, d.AvailableSeats, d.ActiveBookings,
+			d.ExpectedAvailableSeats, d.Delta(), d.Oversold, d.Fixed)
+	}
+	w.Flush()
+
+	if report.Fix && report.DryRun {
+		fmt.Fprintln(out, "\ndry run: no changes were committed")
+	}
+}
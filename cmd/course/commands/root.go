@@ -1,12 +1,15 @@
 package commands
 
 import (
+	"github.com/imrenagicom/demo-app/internal/config"
+
 	"github.com/spf13/cobra"
 )
 
 type opts struct {
 	configPath   string
 	migrationDir string
+	overrides    []string
 }
 
 func NewCommand() *cobra.Command {
@@ -20,8 +23,21 @@ func NewCommand() *cobra.Command {
 	}
 	command.AddCommand(
 		newServer(opts),
+		newBench(opts),
+		newReconcile(opts),
+		newCatalog(opts),
+		newMigrate(opts),
+		newConfig(opts),
+		newToken(opts),
 	)
-	command.PersistentFlags().StringVar(&opts.configPath, "config", "/etc/course/conf/server.yaml", "path to config file")
+	command.PersistentFlags().StringVar(&opts.configPath, "config", "/etc/course/conf/server.yaml", "path to config file. No file is read when it is empty")
+	command.PersistentFlags().StringArrayVar(&opts.overrides, "set", nil, "override a config key, such as --set db.maxOpenConn=50. Takes precedence over the file and the environment")
 	command.PersistentFlags().StringVar(&opts.migrationDir, "migration", "/etc/course/migrations", "migration directory")
 	return command
 }
+
+// loadConfig loads the server config from the config file, the environment
+// and the --set flags.
+func loadConfig(opts *opts, envPrefix string) (config.Server, error) {
+	return config.NewServer(opts.configPath, envPrefix, config.WithOverrides(opts.overrides...))
+}
@@ -2,11 +2,16 @@ package commands
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
+	"time"
 
 	"github.com/imrenagicom/demo-app/course/catalog"
+	"github.com/imrenagicom/demo-app/course/seed"
 	"github.com/imrenagicom/demo-app/course/server/apiserver"
 	"github.com/imrenagicom/demo-app/internal/config"
 	"github.com/imrenagicom/demo-app/internal/instrumentation"
@@ -16,6 +21,7 @@ import (
 
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 type serverOpts struct {
@@ -40,11 +46,16 @@ func newServer(opts *opts) *cobra.Command {
 	return command
 }
 
+type serverStartOpts struct {
+	noMigrate bool
+}
+
 func newServerStart(opts *opts, serverOpts *serverOpts) *cobra.Command {
+	startOpts := &serverStartOpts{}
 	command := &cobra.Command{
 		Use: "start",
 		RunE: func(c *cobra.Command, args []string) error {
-			conf, err := config.NewServer(opts.configPath, serverOpts.envPrefix)
+			conf, err := loadConfig(opts, serverOpts.envPrefix)
 			if err != nil {
 				log.Fatal().Err(err).Msg("unable to load config file")
 			}
@@ -62,30 +73,95 @@ func newServerStart(opts *opts, serverOpts *serverOpts) *cobra.Command {
 				cancel()
 			}()
 
-			log.Debug().Msgf("running migration on %s", opts.migrationDir)
-			if err := postgres.Migrate(opts.migrationDir, conf.DB.DatabaseUrl(), true); err != nil {
-				log.Fatal().Err(err).Msg("unable to run migration")
+			// connecting first waits for postgres to be up before migrating.
+			db, err := postgres.Connect(ctx, conf.DB)
+			if err != nil {
+				log.Fatal().Err(err).Msg("unable to connect to postgres")
+			}
+			rdb, err := redis.Connect(ctx, conf.Redis)
+			if err != nil {
+				log.Error().Err(err).Msg("redis is unavailable, starting without it until it is back")
+			}
+
+			if startOpts.noMigrate {
+				log.Info().Msg("skipping migration")
+			} else {
+				log.Debug().Msgf("running migration on %s", opts.migrationDir)
+				if err := migrateUp(ctx, opts.migrationDir, conf.DB.DatabaseUrl()); err != nil {
+					log.Fatal().Err(err).Msg("unable to run migration")
+				}
 			}
 
+			provider := config.NewProvider(conf, func() (config.Server, error) {
+				return loadConfig(opts, serverOpts.envPrefix)
+			})
+			go provider.Watch(ctx, opts.configPath)
+
 			server := apiserver.NewServer(apiserver.ServerOpts{
-				Config: conf,
+				Config:         conf,
+				ConfigProvider: provider,
 				Clients: &util.Clients{
-					DB:    postgres.NewSQLx(conf.DB),
-					Redis: redis.New(conf.Redis),
+					DB:    db,
+					Redis: rdb,
 				},
 			})
 			return server.Run(ctx)
 		},
 	}
+	command.Flags().BoolVar(&startOpts.noMigrate, "no-migrate", false, "do not apply pending migrations on start")
 	return command
 }
 
+func migrateUp(ctx context.Context, dir, databaseUrl string) error {
+	m, err := postgres.NewMigrator(dir, databaseUrl)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+	return m.Up(ctx)
+}
+
+type serverSeedOpts struct {
+	profile    string
+	courses    int
+	minBatches int
+	maxBatches int
+	maxSeats   int32
+	seats      string
+	currencies []string
+	pastRatio  float64
+	seed       int64
+	truncate   bool
+	output     string
+}
+
 func newServerSeed(opts *opts, serverOpts *serverOpts) *cobra.Command {
+	seedOpts := &serverSeedOpts{}
 	command := &cobra.Command{
 		Use:   "seed",
 		Short: "seed db",
+		Long: `Seed the catalog with generated courses and batches. Sold seats of every
+batch are backed by completed bookings of seeded customers.
+
+A profile sets the defaults of all flags, flags given explicitly override them.
+Profiles:
+` + seedProfiles() + `
+Runs with the same --seed create the same catalog. The seed is printed at the
+end of every run.`,
 		RunE: func(c *cobra.Command, args []string) error {
-			conf, err := config.NewServer(opts.configPath, serverOpts.envPrefix)
+			if seedOpts.output != "text" && seedOpts.output != "json" {
+				return fmt.Errorf("unsupported output %q", seedOpts.output)
+			}
+			profile, err := seed.FindProfile(seedOpts.profile)
+			if err != nil {
+				return err
+			}
+			seedOptions, err := seedOpts.apply(c.Flags(), profile.Options)
+			if err != nil {
+				return err
+			}
+
+			conf, err := loadConfig(opts, serverOpts.envPrefix)
 			if err != nil {
 				log.Fatal().Err(err).Msg("unable to load config file")
 			}
@@ -103,13 +179,86 @@ func newServerSeed(opts *opts, serverOpts *serverOpts) *cobra.Command {
 				cancel()
 			}()
 
+			db, err := postgres.Connect(ctx, conf.DB)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
 			clients := &util.Clients{
-				DB: postgres.NewSQLx(conf.DB),
+				DB: db,
+			}
+			catalogStore := catalog.NewStore(clients.DB, clients.Redis)
+			report, err := seed.NewSeeder(clients.DB, catalogStore).Run(ctx, seedOptions)
+			if err != nil {
+				return err
 			}
-			concertStore := catalog.NewStore(clients.DB, clients.Redis)
-			catalogSvc := catalog.NewService(concertStore, clients.DB)
-			return catalogSvc.Seed(ctx)
+
+			if seedOpts.output == "json" {
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				return enc.Encode(report)
+			}
+			fmt.Printf("seeded %d courses, %d batches and %d bookings in %s\n",
+				report.Courses, report.Batches, report.Bookings, report.FinishedAt.Sub(report.StartedAt).Round(time.Millisecond))
+			fmt.Printf("seed: %d\n", report.Seed)
+			return nil
 		},
 	}
+	defaults := seed.Options{}
+	if p, err := seed.FindProfile(seed.DefaultProfile); err == nil {
+		defaults = p.Options
+	}
+	command.Flags().StringVar(&seedOpts.profile, "profile", seed.DefaultProfile, "scenario profile, one of "+strings.Join(seed.ProfileNames(), ", "))
+	command.Flags().IntVar(&seedOpts.courses, "courses", defaults.Courses, "number of courses")
+	command.Flags().IntVar(&seedOpts.minBatches, "min-batches", defaults.MinBatches, "minimum number of batches per course")
+	command.Flags().IntVar(&seedOpts.maxBatches, "max-batches", defaults.MaxBatches, "maximum number of batches per course")
+	command.Flags().Int32Var(&seedOpts.maxSeats, "max-seats", defaults.MaxSeats, "number of seats of every batch")
+	command.Flags().StringVar(&seedOpts.seats, "seats", string(defaults.Seats), "distribution of sold seats, one of uniform, empty, sold-out, mostly-sold-out")
+	command.Flags().StringSliceVar(&seedOpts.currencies, "currencies", defaults.Currencies, "currencies of the batches")
+	command.Flags().Float64Var(&seedOpts.pastRatio, "past-ratio", defaults.PastRatio, "ratio of batches which have already ended")
+	command.Flags().Int64Var(&seedOpts.seed, "seed", 0, "seed of the random generator. A random seed is used when it is 0")
+	command.Flags().BoolVar(&seedOpts.truncate, "truncate", false, "delete all courses, their batches, bookings and reviews first")
+	command.Flags().StringVarP(&seedOpts.output, "output", "o", "text", "report format, either text or json")
 	return command
 }
+
+// apply overrides the options of the profile with the flags given explicitly.
+func (o *serverSeedOpts) apply(flags *pflag.FlagSet, options seed.Options) (seed.Options, error) {
+	if flags.Changed("courses") {
+		options.Courses = o.courses
+	}
+	if flags.Changed("min-batches") {
+		options.MinBatches = o.minBatches
+	}
+	if flags.Changed("max-batches") {
+		options.MaxBatches = o.maxBatches
+	}
+	if flags.Changed("max-seats") {
+		options.MaxSeats = o.maxSeats
+	}
+	if flags.Changed("seats") {
+		d, err := seed.ParseSeatDistribution(o.seats)
+		if err != nil {
+			return options, err
+		}
+		options.Seats = d
+	}
+	if flags.Changed("currencies") {
+		options.Currencies = o.currencies
+	}
+	if flags.Changed("past-ratio") {
+		options.PastRatio = o.pastRatio
+	}
+	options.Seed = o.seed
+	options.Truncate = o.truncate
+	return options, nil
+}
+
+func seedProfiles() string {
+	var sb strings.Builder
+	for _, name := range seed.ProfileNames() {
+		p, _ := seed.FindProfile(name)
+		fmt.Fprintf(&sb, "  %-18s %s\n", p.Name, p.Description)
+	}
+	return sb.String()
+}
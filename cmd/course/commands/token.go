@@ -0,0 +1,48 @@
+package commands
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/imrenagicom/demo-app/internal/auth"
+
+	"github.com/spf13/cobra"
+)
+
+type tokenOpts struct {
+	envPrefix string
+	customer  string
+	ttl       time.Duration
+}
+
+func newToken(opts *opts) *cobra.Command {
+	tokenOpts := &tokenOpts{}
+	command := &cobra.Command{
+		Use:   "token",
+		Short: "issue a customer token",
+		Long: `Print a token authenticating the customer, signed with the
+auth.customerTokenSecret of the config. Requests send it as
+Authorization: Bearer <token> to book with the customer id and to write reviews.`,
+		Args: cobra.NoArgs,
+		RunE: func(c *cobra.Command, args []string) error {
+			conf, err := loadConfig(opts, tokenOpts.envPrefix)
+			if err != nil {
+				c.SilenceUsage = true
+				return err
+			}
+			authn := auth.NewAuthenticator(conf.Admin.Token, conf.Auth.CustomerTokenSecret)
+			token, err := authn.IssueCustomerToken(tokenOpts.customer, time.Now().Add(tokenOpts.ttl))
+			if err != nil {
+				c.SilenceUsage = true
+				return err
+			}
+			fmt.Println(token)
+			return nil
+		},
+	}
+	command.Flags().StringVar(&tokenOpts.envPrefix, "env-prefix", "COURSE_SERVER", "config prefix")
+	command.Flags().StringVar(&tokenOpts.customer, "customer", "", "id of the customer")
+	command.Flags().DurationVar(&tokenOpts.ttl, "ttl", 24*time.Hour, "validity of the token")
+	command.MarkFlagRequired("customer")
+	return command
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/imrenagicom/demo-app/course/catalog"
+	"github.com/imrenagicom/demo-app/course/customer"
 	pu "github.com/imrenagicom/demo-app/internal/proto"
 	v1 "github.com/imrenagicom/demo-app/pkg/apiclient/course/v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -31,6 +32,23 @@ func (s Status) ApiV1() v1.Status {
 	}
 }
 
+func StatusFromApiV1(s v1.Status) Status {
+	switch s {
+	case v1.Status_CREATED:
+		return StatusCreated
+	case v1.Status_RESERVED:
+		return StatusReserved
+	case v1.Status_COMPLETED:
+		return StatusCompleted
+	case v1.Status_FAILED:
+		return StatusFailed
+	case v1.Status_EXPIRED:
+		return StatusExpired
+	default:
+		return StatusUnknown
+	}
+}
+
 const (
 	StatusUnknown Status = iota
 	StatusCreated
@@ -44,11 +62,12 @@ type builder struct {
 	b *Booking
 }
 
-func (b *builder) WithCustomer(name string, email string, phone string) *builder {
+func (b *builder) WithCustomer(c *customer.Customer) *builder {
 	b.b.Customer = Customer{
-		Name:  name,
-		Email: email,
-		Phone: sql.NullString{Valid: true, String: phone},
+		ID:    uuid.NullUUID{Valid: true, UUID: c.ID},
+		Name:  c.Name,
+		Email: c.Email,
+		Phone: c.Phone,
 	}
 	return b
 }
@@ -121,11 +140,9 @@ func (b *Booking) UpdatePayment(ctx context.Context, paymentType string) error {
 	return nil
 }
 
-const (
-	bookingHoldDuration = 10 * time.Minute
-)
-
-func (b *Booking) Reserve(ctx context.Context, batch *catalog.Batch) error {
+// Reserve takes a seat of the batch for the booking and holds it for hold,
+// after which the booking expires.
+func (b *Booking) Reserve(ctx context.Context, batch *catalog.Batch, hold time.Duration) error {
 	if err := batch.Available(ctx); err != nil {
 		return err
 	}
@@ -139,7 +156,7 @@ func (b *Booking) Reserve(ctx context.Context, batch *catalog.Batch) error {
 		Valid: true,
 	}
 	b.ExpiredAt = sql.NullTime{
-		Time:  now.Add(bookingHoldDuration),
+		Time:  now.Add(hold),
 		Valid: true,
 	}
 	return nil
@@ -167,6 +184,11 @@ func (b Booking) ApiV1() *v1.Booking {
 		batch = b.Batch.ApiV1()
 	}
 
+	var customerID string
+	if b.Customer.ID.Valid {
+		customerID = b.Customer.ID.UUID.String()
+	}
+
 	return &v1.Booking{
 		Number:     b.ID.String(),
 		Course:     course.GetCourseId(),
@@ -180,6 +202,7 @@ func (b Booking) ApiV1() *v1.Booking {
 		ExpiredAt:  pu.FromSQLNullTime(b.ExpiredAt),
 		FailedAt:   pu.FromSQLNullTime(b.FailedAt),
 		Customer: &v1.Customer{
+			CustomerId:  customerID,
 			Name:        b.Customer.Name,
 			Email:       b.Customer.Email,
 			PhoneNumber: b.Customer.Phone.String,
@@ -190,7 +213,9 @@ func (b Booking) ApiV1() *v1.Booking {
 	}
 }
 
+// Customer is the snapshot of customer data at the time the booking is made.
 type Customer struct {
+	ID    uuid.NullUUID
 	Name  string
 	Email string
 	Phone sql.NullString
@@ -26,3 +26,15 @@ func (e ErrInvalidStateChange) Error() string {
 func (e ErrInvalidStateChange) GRPCStatus() *status.Status {
 	return status.New(codes.FailedPrecondition, e.Error())
 }
+
+type ErrInvalidArgument struct {
+	Message string
+}
+
+func (e ErrInvalidArgument) Error() string {
+	return e.Message
+}
+
+func (e ErrInvalidArgument) GRPCStatus() *status.Status {
+	return status.New(codes.InvalidArgument, e.Error())
+}
@@ -1,6 +1,83 @@
 package booking
 
-import "github.com/jmoiron/sqlx"
+import (
+	"time"
+
+	"github.com/imrenagicom/demo-app/internal/chaos"
+	"github.com/imrenagicom/demo-app/internal/pagination"
+	"github.com/imrenagicom/demo-app/internal/retry"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type StoreOption func(*Store)
+
+// WithStoreChaos injects the faults of i into the store methods, with
+// targets such as booking.Store.FindBookingByID.
+func WithStoreChaos(i *chaos.Injector) StoreOption {
+	return func(s *Store) {
+		s.chaos = i
+	}
+}
+
+type ServiceOptions struct {
+	ReservationStrategy ReservationStrategy
+	Limits              func() Limits
+	TransactionRetry    retry.Policy
+	Chaos               *chaos.Injector
+}
+
+type ServiceOption func(*ServiceOptions)
+
+// WithReservationStrategy sets the concurrency control used to take and give
+// back seats. ReservationOptimistic is used by default.
+func WithReservationStrategy(strategy ReservationStrategy) ServiceOption {
+	return func(o *ServiceOptions) {
+		o.ReservationStrategy = strategy
+	}
+}
+
+// WithTransactionRetry sets the retry policy of reservations and expirations
+// failing with transient database errors. They are not retried by default.
+func WithTransactionRetry(p retry.Policy) ServiceOption {
+	return func(o *ServiceOptions) {
+		o.TransactionRetry = p
+	}
+}
+
+// WithChaos injects the faults of i into the reservations. The optimistic
+// strategy injects booking.optimisticReserver.reserveWithRetry between
+// reading and updating the batch, widening the window of conflicts.
+func WithChaos(i *chaos.Injector) ServiceOption {
+	return func(o *ServiceOptions) {
+		o.Chaos = i
+	}
+}
+
+// Limits are the booking settings which may change while the service runs.
+type Limits struct {
+	// HoldDuration is how long a reserved booking holds its seat before it expires.
+	HoldDuration time.Duration
+	// MaxReservationRetries and MaxReleaseRetries are the number of retries of
+	// the optimistic strategy after a conflicting update.
+	MaxReservationRetries int
+	MaxReleaseRetries     int
+}
+
+// DefaultLimits are used unless WithLimits is given.
+var DefaultLimits = Limits{
+	HoldDuration:          10 * time.Minute,
+	MaxReservationRetries: 5,
+	MaxReleaseRetries:     5,
+}
+
+// WithLimits sets the function returning the current limits. It is called
+// for every booking, so the limits can change without a restart.
+func WithLimits(fn func() Limits) ServiceOption {
+	return func(o *ServiceOptions) {
+		o.Limits = fn
+	}
+}
 
 type FindOptions struct {
 	Tx           *sqlx.Tx
@@ -48,13 +125,11 @@ func WithCreateTx(tx *sqlx.Tx) CreateOption {
 type ListOptions struct {
 	Tx            *sqlx.Tx
 	Limit         uint64
-	Page          uint64
+	PageToken     string
+	Order         pagination.Order
 	InvoiceNumber string
 	Status        Status
-}
-
-func (f ListOptions) GetOffset() uint64 {
-	return f.Page * f.Limit
+	CustomerID    string
 }
 
 type ListOption func(*ListOptions)
@@ -65,6 +140,28 @@ func WithFindAllTx(tx *sqlx.Tx) ListOption {
 	}
 }
 
+func WithFindAllMaxResults(limit uint64) ListOption {
+	return func(o *ListOptions) {
+		if limit > 0 {
+			o.Limit = limit
+		}
+	}
+}
+
+// WithFindAllNextPage continues listing after the page the token was returned with.
+// The token is verified when the list is queried.
+func WithFindAllNextPage(token string) ListOption {
+	return func(o *ListOptions) {
+		o.PageToken = token
+	}
+}
+
+func WithFindAllOrder(order pagination.Order) ListOption {
+	return func(o *ListOptions) {
+		o.Order = order
+	}
+}
+
 func WithFindAllInvoiceNumber(invoiceNumber string) ListOption {
 	return func(o *ListOptions) {
 		o.InvoiceNumber = invoiceNumber
@@ -76,3 +173,9 @@ func WithFindAllStatus(status Status) ListOption {
 		o.Status = status
 	}
 }
+
+func WithFindAllCustomer(customerID string) ListOption {
+	return func(o *ListOptions) {
+		o.CustomerID = customerID
+	}
+}
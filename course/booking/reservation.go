@@ -0,0 +1,285 @@
+package booking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/imrenagicom/demo-app/course/catalog"
+	"github.com/imrenagicom/demo-app/internal/chaos"
+	"github.com/imrenagicom/demo-app/internal/db"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// ReservationStrategy is the concurrency control used to take and give back
+// seats of a batch when bookings are reserved and expired.
+type ReservationStrategy string
+
+const (
+	// ReservationOptimistic reads the batch and updates it only if its version
+	// has not changed, retrying on conflicts.
+	ReservationOptimistic ReservationStrategy = "optimistic"
+	// ReservationRowLock locks the batch row with SELECT ... FOR UPDATE.
+	ReservationRowLock ReservationStrategy = "row_lock"
+	// ReservationAtomic decrements the seats in a single conditional UPDATE.
+	ReservationAtomic ReservationStrategy = "atomic"
+	// ReservationRedis takes the seat from a redis seat counter first and
+	// then applies the same change to postgres atomically. The counter is
+	// only a gate: it is seeded from postgres, corrected from postgres when
+	// seats are released or reconciled, and expires after 30 seconds.
+	ReservationRedis ReservationStrategy = "redis"
+)
+
+var ReservationStrategies = []ReservationStrategy{
+	ReservationOptimistic,
+	ReservationRowLock,
+	ReservationAtomic,
+	ReservationRedis,
+}
+
+func ParseReservationStrategy(s string) (ReservationStrategy, error) {
+	if s == "" {
+		return ReservationOptimistic, nil
+	}
+	for _, rs := range ReservationStrategies {
+		if string(rs) == s {
+			return rs, nil
+		}
+	}
+	return "", fmt.Errorf("unknown reservation strategy %q", s)
+}
+
+// seatReserver takes and gives back the seat of the batch of a booking.
+type seatReserver interface {
+	// reserve reserves the booking and takes its seat as part of tx.
+	reserve(ctx context.Context, tx *sqlx.Tx, b *Booking) error
+	// release gives the seat of the booking back as part of tx.
+	release(ctx context.Context, tx *sqlx.Tx, b *Booking) error
+	// abort reverts changes done outside of tx by a successful reserve or
+	// release when tx is rolled back afterwards.
+	abort(ctx context.Context, b *Booking, reserved bool)
+}
+
+func newSeatReserver(strategy ReservationStrategy, catalogStore *catalog.Store, limits func() Limits, chaos *chaos.Injector) seatReserver {
+	switch strategy {
+	case ReservationRowLock:
+		return rowLockReserver{catalogStore: catalogStore, limits: limits}
+	case ReservationAtomic:
+		return atomicReserver{catalogStore: catalogStore, limits: limits}
+	case ReservationRedis:
+		return redisReserver{atomicReserver{catalogStore: catalogStore, limits: limits}}
+	default:
+		return optimisticReserver{catalogStore: catalogStore, limits: limits, chaos: chaos}
+	}
+}
+
+type optimisticReserver struct {
+	catalogStore *catalog.Store
+	limits       func() Limits
+	chaos        *chaos.Injector
+}
+
+func (r optimisticReserver) reserve(ctx context.Context, tx *sqlx.Tx, b *Booking) error {
+	return r.reserveWithRetry(ctx, tx, b, 0)
+}
+
+func (r optimisticReserver) reserveWithRetry(ctx context.Context, tx *sqlx.Tx, b *Booking, retryCount int) error {
+	if retryCount > r.limits().MaxReservationRetries {
+		return ErrReservationMaxRetryExceeded
+	}
+
+	tc, err := r.catalogStore.FindCourseBatchByIDAndCourseID(ctx, b.Batch.ID.String(), b.Course.ID.String(), catalog.WithFindTx(tx))
+	if err != nil {
+		return err
+	}
+
+	if err := b.Reserve(ctx, tc, r.limits().HoldDuration); err != nil {
+		return err
+	}
+
+	if err := r.chaos.Inject(ctx, "booking.optimisticReserver.reserveWithRetry"); err != nil {
+		return err
+	}
+
+	err = r.catalogStore.UpdateBatchAvailableSeats(ctx, tc, catalog.WithUpdateTx(tx))
+	if err != nil && !errors.Is(err, db.ErrNoRowUpdated) {
+		return err
+	}
+	if errors.Is(err, db.ErrNoRowUpdated) {
+		return r.reserveWithRetry(ctx, tx, b, retryCount+1)
+	}
+	return nil
+}
+
+func (r optimisticReserver) release(ctx context.Context, tx *sqlx.Tx, b *Booking) error {
+	return r.releaseWithRetry(ctx, tx, b, 0)
+}
+
+func (r optimisticReserver) releaseWithRetry(ctx context.Context, tx *sqlx.Tx, b *Booking, retryCount int) error {
+	if retryCount > r.limits().MaxReleaseRetries {
+		return ErrReleaseMaxRetryExceeded
+	}
+
+	batch, err := r.catalogStore.FindCourseBatchByIDAndCourseID(ctx, b.Batch.ID.String(), b.Course.ID.String(), catalog.WithFindTx(tx))
+	if err != nil {
+		return err
+	}
+
+	err = batch.Allocate(ctx, 1)
+	if err != nil {
+		return err
+	}
+
+	err = r.catalogStore.UpdateBatchAvailableSeats(ctx, batch, catalog.WithUpdateTx(tx))
+	if err != nil && !errors.Is(err, db.ErrNoRowUpdated) {
+		return err
+	}
+	if errors.Is(err, db.ErrNoRowUpdated) {
+		return r.releaseWithRetry(ctx, tx, b, retryCount+1)
+	}
+	return nil
+}
+
+func (r optimisticReserver) abort(ctx context.Context, b *Booking, reserved bool) {}
+
+type rowLockReserver struct {
+	catalogStore *catalog.Store
+	limits       func() Limits
+}
+
+func (r rowLockReserver) reserve(ctx context.Context, tx *sqlx.Tx, b *Booking) error {
+	batch, err := r.catalogStore.FindCourseBatchByIDAndCourseID(ctx, b.Batch.ID.String(), b.Course.ID.String(),
+		catalog.WithFindTx(tx), catalog.WithFindForUpdate())
+	if err != nil {
+		return err
+	}
+
+	if err := b.Reserve(ctx, batch, r.limits().HoldDuration); err != nil {
+		return err
+	}
+
+	// the row is locked, so the version can not change until tx ends.
+	return r.catalogStore.UpdateBatchAvailableSeats(ctx, batch, catalog.WithUpdateTx(tx))
+}
+
+func (r rowLockReserver) release(ctx context.Context, tx *sqlx.Tx, b *Booking) error {
+	batch, err := r.catalogStore.FindCourseBatchByIDAndCourseID(ctx, b.Batch.ID.String(), b.Course.ID.String(),
+		catalog.WithFindTx(tx), catalog.WithFindForUpdate())
+	if err != nil {
+		return err
+	}
+
+	if err := batch.Allocate(ctx, 1); err != nil {
+		return err
+	}
+	return r.catalogStore.UpdateBatchAvailableSeats(ctx, batch, catalog.WithUpdateTx(tx))
+}
+
+func (r rowLockReserver) abort(ctx context.Context, b *Booking, reserved bool) {}
+
+type atomicReserver struct {
+	catalogStore *catalog.Store
+	limits       func() Limits
+}
+
+func (r atomicReserver) reserve(ctx context.Context, tx *sqlx.Tx, b *Booking) error {
+	batch, err := r.catalogStore.FindCourseBatchByIDAndCourseID(ctx, b.Batch.ID.String(), b.Course.ID.String(), catalog.WithFindTx(tx))
+	if err != nil {
+		return err
+	}
+
+	// the batch read above is only used to validate the booking. The seats
+	// are taken by a conditional update which fails if they are gone.
+	// Reserve refuses batches which are not for sale, as the update does, so
+	// that batches with unlimited seats are refused as well.
+	if err := b.Reserve(ctx, batch, r.limits().HoldDuration); err != nil {
+		return err
+	}
+	if batch.MaxSeats <= 0 {
+		return nil
+	}
+	return r.catalogStore.ReserveBatchSeats(ctx, batch.ID, 1, catalog.WithUpdateTx(tx))
+}
+
+func (r atomicReserver) release(ctx context.Context, tx *sqlx.Tx, b *Booking) error {
+	return r.catalogStore.ReleaseBatchSeats(ctx, b.Batch.ID, 1, catalog.WithUpdateTx(tx))
+}
+
+func (r atomicReserver) abort(ctx context.Context, b *Booking, reserved bool) {}
+
+// redisReserver uses redis seat counter as the gate in front of the update of
+// postgres, so that requests for a sold out batch are rejected without
+// taking the row lock of the batch. The batch is still read from postgres
+// first, to seed the counter and check the booking. Postgres remains the
+// source of truth and is updated atomically afterwards. The counter is set
+// to the available seats of postgres when a seat is released and when
+// inventory.Reconciler fixes the batch, and it expires 30 seconds after
+// being seeded, which bounds the drift of seats changed without it, such as
+// while redis is unavailable.
+type redisReserver struct {
+	atomicReserver
+}
+
+func (r redisReserver) reserve(ctx context.Context, tx *sqlx.Tx, b *Booking) error {
+	batch, err := r.catalogStore.FindCourseBatchByIDAndCourseID(ctx, b.Batch.ID.String(), b.Course.ID.String(), catalog.WithFindTx(tx))
+	if err != nil {
+		return err
+	}
+
+	available := batch.AvailableSeats
+	if err := b.Reserve(ctx, batch, r.limits().HoldDuration); err != nil {
+		return err
+	}
+	if batch.MaxSeats <= 0 {
+		return nil
+	}
+
+	if err := r.catalogStore.ReserveSeatsInCache(ctx, batch.ID, 1, available); err != nil {
+		if !errors.Is(err, catalog.ErrCacheUnavailable) {
+			return err
+		}
+		// without redis the seat is only taken in postgres, as the atomic
+		// strategy does. A later abort may bump a counter which was not
+		// decremented, which lasts until the counter expires.
+		log.Ctx(ctx).Warn().Err(err).Str("batch", batch.ID.String()).Msg("reserving seat without the seat cache")
+		return r.catalogStore.ReserveBatchSeats(ctx, batch.ID, 1, catalog.WithUpdateTx(tx))
+	}
+
+	if err := r.catalogStore.ReserveBatchSeats(ctx, batch.ID, 1, catalog.WithUpdateTx(tx)); err != nil {
+		r.abort(ctx, b, true)
+		return err
+	}
+	return nil
+}
+
+func (r redisReserver) release(ctx context.Context, tx *sqlx.Tx, b *Booking) error {
+	if err := r.atomicReserver.release(ctx, tx, b); err != nil {
+		return err
+	}
+	// the batch row stays locked by the release until tx ends, so that the
+	// seats read here are those of postgres once tx commits.
+	batch, err := r.catalogStore.FindCourseBatchByIDAndCourseID(ctx, b.Batch.ID.String(), b.Course.ID.String(), catalog.WithFindTx(tx))
+	if err != nil {
+		return err
+	}
+	if batch.MaxSeats <= 0 {
+		return nil
+	}
+	if err := r.catalogStore.SetSeatsInCache(ctx, batch.ID, batch.AvailableSeats); err != nil {
+		// the counter will be synchronized with postgres once it expires.
+		log.Ctx(ctx).Warn().Err(err).Str("batch", b.Batch.ID.String()).Msg("unable to correct seat counter in cache")
+	}
+	return nil
+}
+
+func (r redisReserver) abort(ctx context.Context, b *Booking, reserved bool) {
+	n := int32(1)
+	if !reserved {
+		n = -1
+	}
+	if err := r.catalogStore.ReleaseSeatsInCache(ctx, b.Batch.ID, n); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Str("batch", b.Batch.ID.String()).Msg("unable to revert seat counter in cache")
+	}
+}
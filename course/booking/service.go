@@ -2,46 +2,70 @@ package booking
 
 import (
 	"context"
-	"errors"
-	"math/rand"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/imrenagicom/demo-app/course/catalog"
+	"github.com/imrenagicom/demo-app/course/customer"
+	"github.com/imrenagicom/demo-app/internal/auth"
 	"github.com/imrenagicom/demo-app/internal/db"
+	"github.com/imrenagicom/demo-app/internal/pagination"
+	"github.com/imrenagicom/demo-app/internal/retry"
 	v1 "github.com/imrenagicom/demo-app/pkg/apiclient/course/v1"
 	"github.com/jmoiron/sqlx"
-)
-
-const (
-	maxReservationAttemptRetry = 5
-	maxReleaseAttemptRetry     = 5
+	"github.com/rs/zerolog/log"
 )
 
 func NewService(db *sqlx.DB,
 	bookingStore *Store,
 	catalogStore *catalog.Store,
+	customerService *customer.Service,
+	opts ...ServiceOption,
 ) *Service {
+	options := &ServiceOptions{
+		ReservationStrategy: ReservationOptimistic,
+		Limits:              func() Limits { return DefaultLimits },
+		TransactionRetry:    retry.NoRetry,
+	}
+	for _, o := range opts {
+		o(options)
+	}
 	return &Service{
-		db:           db,
-		bookingStore: bookingStore,
-		catalogStore: catalogStore,
+		db:              db,
+		bookingStore:    bookingStore,
+		catalogStore:    catalogStore,
+		customerService: customerService,
+		reserver:        newSeatReserver(options.ReservationStrategy, catalogStore, options.Limits, options.Chaos),
+		txRetry:         options.TransactionRetry,
 	}
 }
 
 type Service struct {
-	db           *sqlx.DB
-	bookingStore *Store
-	catalogStore *catalog.Store
+	db              *sqlx.DB
+	bookingStore    *Store
+	catalogStore    *catalog.Store
+	customerService *customer.Service
+	reserver        seatReserver
+	txRetry         retry.Policy
+}
+
+// inTx runs fn again when it fails with a transient database error, such as
+// a serialization failure. fn must start its own transaction and undo its
+// changes outside of it when it fails.
+func (s Service) inTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return retry.Do(ctx, s.txRetry, db.IsTransient, fn, func(attempt int, err error, backoff time.Duration) {
+		log.Ctx(ctx).Warn().Err(err).Int("attempt", attempt).Dur("backoff", backoff).Msg("retrying booking transaction")
+	})
 }
 
 // CreateBooking creates a new booking for the given course and batch and emits BookingCreated event.
 func (s Service) CreateBooking(ctx context.Context, req *v1.CreateBookingRequest) (*Booking, error) {
-	course, err := s.catalogStore.FindCourseByID(ctx, req.Booking.GetCourse())
+	course, err := s.catalogStore.FindCourse(ctx, req.Booking.GetCourse())
 	if err != nil {
 		return nil, err
 	}
 
-	batch, err := s.catalogStore.FindCourseBatchByID(ctx, req.Booking.GetBatch())
+	batch, err := s.catalogStore.FindBatchOfCourse(ctx, course, req.Booking.GetBatch())
 	if err != nil {
 		return nil, err
 	}
@@ -52,9 +76,11 @@ func (s Service) CreateBooking(ctx context.Context, req *v1.CreateBookingRequest
 
 	builder := For(course, batch)
 	if req.Booking.Customer != nil {
-		// TODO validate customer data
-		c := req.Booking.Customer
-		builder.WithCustomer(c.Name, c.Email, c.PhoneNumber)
+		c, err := s.customerService.FindOrCreate(ctx, req.Booking.Customer)
+		if err != nil {
+			return nil, err
+		}
+		builder.WithCustomer(c)
 	}
 	b := builder.Build()
 
@@ -66,6 +92,18 @@ func (s Service) CreateBooking(ctx context.Context, req *v1.CreateBookingRequest
 }
 
 func (s Service) ReserveBooking(ctx context.Context, req *v1.ReserveBookingRequest) (*Booking, error) {
+	var booking *Booking
+	err := s.inTx(ctx, func(ctx context.Context) (err error) {
+		booking, err = s.reserveBooking(ctx, req)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return booking, nil
+}
+
+func (s Service) reserveBooking(ctx context.Context, req *v1.ReserveBookingRequest) (*Booking, error) {
 	tx, err := s.db.BeginTxx(ctx, nil)
 	if err != nil {
 		return nil, err
@@ -77,56 +115,36 @@ func (s Service) ReserveBooking(ctx context.Context, req *v1.ReserveBookingReque
 		return nil, err
 	}
 
-	if err = s.reserveWithRetry(ctx, tx, booking, 0); err != nil {
+	if err = s.reserver.reserve(ctx, tx, booking); err != nil {
 		tx.Rollback()
 		return nil, err
 	}
 
 	if err = s.bookingStore.UpdateBookingStatus(ctx, booking, WithUpdateTx(tx)); err != nil {
 		tx.Rollback()
+		s.reserver.abort(ctx, booking, true)
 		return nil, err
 	}
 
 	if err = tx.Commit(); err != nil {
 		tx.Rollback()
+		s.reserver.abort(ctx, booking, true)
 		return nil, err
 	}
 	return booking, nil
 }
 
-func (s Service) reserveWithRetry(ctx context.Context, tx *sqlx.Tx, b *Booking, retryCount int) error {
-	if retryCount > maxReservationAttemptRetry {
-		return ErrReservationMaxRetryExceeded
-	}
-
-	tc, err := s.catalogStore.FindCourseBatchByIDAndCourseID(ctx, b.Batch.ID.String(), b.Course.ID.String(), catalog.WithFindTx(tx))
-	if err != nil {
-		return err
-	}
-
-	if err := b.Reserve(ctx, tc); err != nil {
-		return err
-	}
-
-	if rand.Intn(5)+1 == 3 {
-		<-time.After(300 * time.Millisecond)
-	}
-
-	err = s.catalogStore.UpdateBatchAvailableSeats(ctx, tc, catalog.WithUpdateTx(tx))
-	if err != nil && !errors.Is(err, db.ErrNoRowUpdated) {
-		return err
-	}
-	if errors.Is(err, db.ErrNoRowUpdated) {
-		return s.reserveWithRetry(ctx, tx, b, retryCount+1)
-	}
-	return nil
-}
-
 func (s Service) GetBooking(ctx context.Context, req *v1.GetBookingRequest) (*Booking, error) {
 	return s.bookingStore.FindBookingByID(ctx, req.GetBooking())
 }
 
 func (s Service) ExpireBooking(ctx context.Context, req *v1.ExpireBookingRequest) error {
+	return s.inTx(ctx, func(ctx context.Context) error {
+		return s.expireBooking(ctx, req)
+	})
+}
+
+func (s Service) expireBooking(ctx context.Context, req *v1.ExpireBookingRequest) error {
 	tx, err := s.db.BeginTxx(ctx, nil)
 	if err != nil {
 		return err
@@ -143,48 +161,49 @@ func (s Service) ExpireBooking(ctx context.Context, req *v1.ExpireBookingRequest
 		return err
 	}
 
-	ctx, _ = context.WithTimeout(ctx, 5*time.Millisecond)
-	if err = s.bookingStore.UpdateBookingStatus(ctx, b, WithUpdateTx(tx)); err != nil {
+	queryCtx, cancel := context.WithTimeout(ctx, 5*time.Millisecond)
+	defer cancel()
+	if err = s.bookingStore.UpdateBookingStatus(queryCtx, b, WithUpdateTx(tx)); err != nil {
 		tx.Rollback()
 		return err
 	}
 
-	if err = s.releaseBooking(ctx, tx, b, 0); err != nil {
+	if err = s.reserver.release(queryCtx, tx, b); err != nil {
 		tx.Rollback()
 		return err
 	}
 
 	if err = tx.Commit(); err != nil {
+		// the deadline of queryCtx has often passed by now, the release of
+		// the seat counter is reverted with the context of the request.
+		s.reserver.abort(ctx, b, false)
 		return err
 	}
 	return nil
 }
 
-func (s Service) releaseBooking(ctx context.Context, tx *sqlx.Tx, b *Booking, retryCount int) error {
-	if retryCount > maxReleaseAttemptRetry {
-		return ErrReleaseMaxRetryExceeded
-	}
-
-	batch, err := s.catalogStore.FindCourseBatchByIDAndCourseID(ctx, b.Batch.ID.String(), b.Course.ID.String(), catalog.WithFindTx(tx))
-	if err != nil {
-		return err
-	}
-
-	err = batch.Allocate(ctx, 1)
-	if err != nil {
-		return err
-	}
-
-	err = s.catalogStore.UpdateBatchAvailableSeats(ctx, batch, catalog.WithUpdateTx(tx))
-	if err != nil && !errors.Is(err, db.ErrNoRowUpdated) {
-		return err
-	}
-	if errors.Is(err, db.ErrNoRowUpdated) {
-		return s.releaseBooking(ctx, tx, b, retryCount+1)
-	}
-	return nil
-}
-
 func (s Service) ListBookings(ctx context.Context, req *v1.ListBookingsRequest) ([]Booking, string, error) {
-	return s.bookingStore.FindAllBookings(ctx, WithFindAllInvoiceNumber(req.GetInvoice()))
+	order, err := pagination.ParseOrder(req.GetOrderBy(), DefaultBookingOrder, BookingOrderFields...)
+	if err != nil {
+		return nil, "", err
+	}
+	// customers only list their own bookings, all bookings are listed by admins.
+	if req.GetCustomer() != "" {
+		if _, err := uuid.Parse(req.GetCustomer()); err != nil {
+			return nil, "", ErrInvalidArgument{Message: "customer must be a valid customer id"}
+		}
+		if err := auth.RequireCustomer(ctx, req.GetCustomer()); err != nil {
+			return nil, "", err
+		}
+	} else if err := auth.RequireAdmin(ctx); err != nil {
+		return nil, "", err
+	}
+	return s.bookingStore.FindAllBookings(ctx,
+		WithFindAllMaxResults(req.GetPageSize()),
+		WithFindAllNextPage(req.GetPageToken()),
+		WithFindAllOrder(order),
+		WithFindAllInvoiceNumber(req.GetInvoice()),
+		WithFindAllStatus(StatusFromApiV1(req.GetStatus())),
+		WithFindAllCustomer(req.GetCustomer()),
+	)
 }
@@ -6,7 +6,9 @@ import (
 	"time"
 
 	"github.com/imrenagicom/demo-app/course/catalog"
+	"github.com/imrenagicom/demo-app/internal/chaos"
 	"github.com/imrenagicom/demo-app/internal/db"
+	"github.com/imrenagicom/demo-app/internal/pagination"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
@@ -17,18 +19,23 @@ var (
 	bookingTTL = 10 * time.Minute
 )
 
-func NewStore(db *sqlx.DB, redis redis.UniversalClient) *Store {
-	return &Store{
+func NewStore(db *sqlx.DB, redis redis.UniversalClient, opts ...StoreOption) *Store {
+	s := &Store{
 		db:      db,
 		dbCache: sq.NewStmtCache(db),
 		redis:   redis,
 	}
+	for _, o := range opts {
+		o(s)
+	}
+	return s
 }
 
 type Store struct {
 	db      *sqlx.DB
 	dbCache *sq.StmtCache
 	redis   redis.UniversalClient
+	chaos   *chaos.Injector
 }
 
 func (s *Store) Clear() error {
@@ -36,6 +43,9 @@ func (s *Store) Clear() error {
 }
 
 func (s *Store) CreateBooking(ctx context.Context, booking *Booking, opts ...CreateOption) error {
+	if err := s.chaos.Inject(ctx, "booking.Store.CreateBooking"); err != nil {
+		return err
+	}
 	options := &CreateOptions{}
 	for _, o := range opts {
 		o(options)
@@ -46,10 +56,10 @@ func (s *Store) CreateBooking(ctx context.Context, booking *Booking, opts ...Cre
 		sb = sb.RunWith(options.Tx)
 	}
 	insertBooking := sb.Insert("bookings").
-		Columns("id", "course_id", "course_batch_id", "price", "currency", "status", "created_at", "updated_at", "cust_name", "cust_email", "cust_phone").
+		Columns("id", "course_id", "course_batch_id", "price", "currency", "status", "created_at", "updated_at", "cust_name", "cust_email", "cust_phone", "customer_id").
 		Values(booking.ID, booking.Course.ID, booking.Batch.ID,
 			booking.Price, booking.Currency, booking.Status,
-			booking.CreatedAt, booking.UpdatedAt, booking.Customer.Name, booking.Customer.Email, booking.Customer.Phone).
+			booking.CreatedAt, booking.UpdatedAt, booking.Customer.Name, booking.Customer.Email, booking.Customer.Phone, booking.Customer.ID).
 		PlaceholderFormat(sq.Dollar)
 
 	_, err := insertBooking.ExecContext(ctx)
@@ -77,7 +87,7 @@ func (s *Store) FindBookingByID(ctx context.Context, ID string, opts ...FindOpti
 	}
 	query := sb.Select("b.id", "c.id", "cb.id", "b.price", "b.currency", "b.status",
 		"b.reserved_at", "b.expired_at", "b.paid_at", "b.created_at", "b.updated_at", "b.version",
-		"b.cust_name", "b.cust_email", "b.cust_phone", "b.invoice_number", "b.payment_type",
+		"b.cust_name", "b.cust_email", "b.cust_phone", "b.customer_id", "b.invoice_number", "b.payment_type",
 		"c.name", "c.slug", "cb.name", "cb.start_date", "cb.end_date").
 		From("bookings b").
 		LeftJoin("courses c ON b.course_id = c.id").
@@ -88,20 +98,22 @@ func (s *Store) FindBookingByID(ctx context.Context, ID string, opts ...FindOpti
 	err := query.QueryRowContext(ctx).
 		Scan(&b.ID, &b.Course.ID, &b.Batch.ID, &b.Price, &b.Currency, &b.Status,
 			&b.ReservedAt, &b.ExpiredAt, &b.PaidAt, &b.CreatedAt, &b.UpdatedAt, &b.Version,
-			&b.Customer.Name, &b.Customer.Email, &b.Customer.Phone, &b.InvoiceNumber, &b.PaymentType,
+			&b.Customer.Name, &b.Customer.Email, &b.Customer.Phone, &b.Customer.ID, &b.InvoiceNumber, &b.PaymentType,
 			&b.Course.Name, &b.Course.Slug, &b.Batch.Name, &b.Batch.StartDate, &b.Batch.EndDate)
 	if err != nil {
 		return nil, err
 	}
 
-	if rand.Intn(5)+1 == 3 {
-		<-time.After(time.Duration(rand.Intn(300)) * time.Millisecond)
+	if err := s.chaos.Inject(ctx, "booking.Store.FindBookingByID"); err != nil {
+		return nil, err
 	}
-
 	return &b, nil
 }
 
 func (s *Store) UpdateBookingStatus(ctx context.Context, booking *Booking, opts ...UpdateOption) error {
+	if err := s.chaos.Inject(ctx, "booking.Store.UpdateBookingStatus"); err != nil {
+		return err
+	}
 	options := &UpdateOptions{}
 	for _, o := range opts {
 		o(options)
@@ -137,6 +149,9 @@ func (s *Store) UpdateBookingStatus(ctx context.Context, booking *Booking, opts
 }
 
 func (s *Store) UpdateBookingPayment(ctx context.Context, booking *Booking, opts ...UpdateOption) error {
+	if err := s.chaos.Inject(ctx, "booking.Store.UpdateBookingPayment"); err != nil {
+		return err
+	}
 	options := &UpdateOptions{}
 	for _, o := range opts {
 		o(options)
@@ -169,6 +184,9 @@ func (s *Store) UpdateBookingPayment(ctx context.Context, booking *Booking, opts
 }
 
 func (s *Store) FindAllBookings(ctx context.Context, opts ...ListOption) ([]Booking, string, error) {
+	if err := s.chaos.Inject(ctx, "booking.Store.FindAllBookings"); err != nil {
+		return nil, "", err
+	}
 	options := &ListOptions{
 		Limit: 5,
 	}
@@ -181,6 +199,15 @@ func (s *Store) FindAllBookings(ctx context.Context, opts ...ListOption) ([]Book
 		sb = sb.RunWith(options.Tx)
 	}
 
+	if options.Order.Field == "" {
+		options.Order = DefaultBookingOrder
+	}
+	fingerprint := pagination.Fingerprint("bookings", options.InvoiceNumber, options.Status, options.CustomerID)
+	cursor, err := pagination.Decode(options.PageToken, options.Order, fingerprint)
+	if err != nil {
+		return nil, "", err
+	}
+
 	var filter map[string]interface{} = map[string]interface{}{
 		"b.deleted_at": nil,
 	}
@@ -190,22 +217,29 @@ func (s *Store) FindAllBookings(ctx context.Context, opts ...ListOption) ([]Book
 	if options.InvoiceNumber != "" {
 		filter["b.invoice_number"] = options.InvoiceNumber
 	}
+	if options.CustomerID != "" {
+		filter["b.customer_id"] = options.CustomerID
+	}
 	query := sb.Select("b.id", "c.id", "cb.id", "b.price", "b.currency", "b.status",
 		"b.reserved_at", "b.expired_at", "b.paid_at", "b.created_at", "b.updated_at", "b.version",
-		"b.cust_name", "b.cust_email", "b.cust_phone", "b.invoice_number", "b.payment_type",
+		"b.cust_name", "b.cust_email", "b.cust_phone", "b.customer_id", "b.invoice_number", "b.payment_type",
 		"c.name", "c.slug", "cb.name", "cb.start_date", "cb.end_date").
 		From("bookings b").
 		LeftJoin("courses c ON b.course_id = c.id").
 		LeftJoin("course_batches cb ON b.course_batch_id = cb.id").
 		Where(filter).
-		Offset(uint64(options.GetOffset())).
-		Limit(uint64(options.Limit)).
+		OrderBy(options.Order.OrderBy("b.created_at", "b.id")...).
+		Limit(options.Limit + 1).
 		PlaceholderFormat(sq.Dollar)
+	if cursor != nil {
+		query = query.Where(options.Order.After("b.created_at", "b.id", cursor.Time, cursor.ID))
+	}
 
 	rows, err := query.QueryContext(ctx)
 	if err != nil {
 		return nil, "", err
 	}
+	defer rows.Close()
 
 	var bookings []Booking
 	for rows.Next() {
@@ -217,15 +251,37 @@ func (s *Store) FindAllBookings(ctx context.Context, opts ...ListOption) ([]Book
 		if err := rows.
 			Scan(&b.ID, &b.Course.ID, &b.Batch.ID, &b.Price, &b.Currency, &b.Status,
 				&b.ReservedAt, &b.ExpiredAt, &b.PaidAt, &b.CreatedAt, &b.UpdatedAt, &b.Version,
-				&b.Customer.Name, &b.Customer.Email, &b.Customer.Phone, &b.InvoiceNumber, &b.PaymentType,
+				&b.Customer.Name, &b.Customer.Email, &b.Customer.Phone, &b.Customer.ID, &b.InvoiceNumber, &b.PaymentType,
 				&b.Course.Name, &b.Course.Slug, &b.Batch.Name, &b.Batch.StartDate, &b.Batch.EndDate); err != nil {
 			return nil, "", err
 		}
 		bookings = append(bookings, b)
 	}
-	return bookings, "", nil
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	var nextPage string
+	if uint64(len(bookings)) > options.Limit {
+		bookings = bookings[:options.Limit]
+		last := bookings[len(bookings)-1]
+		nextPage = pagination.Encode(pagination.Cursor{
+			Order:  options.Order.String(),
+			Filter: fingerprint,
+			Time:   last.CreatedAt,
+			ID:     last.ID,
+		})
+	}
+	return bookings, nextPage, nil
 }
 
+var (
+	// DefaultBookingOrder lists the most recent bookings first.
+	DefaultBookingOrder = pagination.Order{Field: "created_at", Desc: true}
+	// BookingOrderFields are the fields bookings can be ordered by.
+	BookingOrderFields = []string{"created_at"}
+)
+
 func bookingCacheKey(id string) string {
 	return "booking:" + id
 }
@@ -25,6 +25,7 @@ type Batch struct {
 	UpdatedAt      time.Time
 	DeletedAt      sql.NullTime
 	Name           string
+	Slug           string
 	MaxSeats       int32
 	AvailableSeats int32
 	Price          float64
@@ -33,36 +34,53 @@ type Batch struct {
 	StartDate      sql.NullTime
 	EndDate        sql.NullTime
 	Version        int64
+	Instructors    []Instructor
+	// SalesOpenAt and SalesCloseAt limit when seats can be reserved.
+	SalesOpenAt  sql.NullTime
+	SalesCloseAt sql.NullTime
+	// PublishAt and UnpublishAt schedule the status changes done by the Scheduler.
+	PublishAt   sql.NullTime
+	UnpublishAt sql.NullTime
+
+	// CourseSlug is the slug of the course the batch belongs to. It is only
+	// set when the batch is loaded together with its course.
+	CourseSlug string
 }
 
 func (b Batch) ApiV1() *v1.Batch {
-	var startDate, endDate *timestamppb.Timestamp
-	if b.StartDate.Valid {
-		startDate = timestamppb.New(b.StartDate.Time)
-	}
-	if b.EndDate.Valid {
-		endDate = timestamppb.New(b.EndDate.Time)
-	}
-
 	return &v1.Batch{
 		DisplayName: b.Name,
-		Name:        string(b.ID.String()), // TODO change with slug
+		Name:        b.resourceName(),
 		BatchId:     b.ID.String(),
+		Course:      b.CourseSlug,
 		Price: &v1.Price{
 			Value:    b.Price,
 			Currency: b.Currency,
 		},
 		MaxSeats:       b.MaxSeats,
 		AvailableSeats: b.AvailableSeats,
-		StartDate:      startDate,
-		EndDate:        endDate,
+		StartDate:      timestampPb(b.StartDate),
+		EndDate:        timestampPb(b.EndDate),
+		Instructors:    instructorsPkg(b.Instructors),
+		SalesOpenAt:    timestampPb(b.SalesOpenAt),
+		SalesCloseAt:   timestampPb(b.SalesCloseAt),
+		UnpublishAt:    timestampPb(b.UnpublishAt),
+	}
+}
+
+func timestampPb(t sql.NullTime) *timestamppb.Timestamp {
+	if !t.Valid {
+		return nil
 	}
+	return timestamppb.New(t.Time)
 }
 
 var (
 	ErrNotEnoughSeats           = errors.New("no seat available")
 	ErrClassSoldOut             = errors.New("class is sold out")
 	ErrClassNotAvailableForSale = errors.New("class is not available for sale")
+	ErrSalesNotOpen             = errors.New("sales of the class are not open yet")
+	ErrSalesClosed              = errors.New("sales of the class are closed")
 )
 
 func (b *Batch) Reserve(ctx context.Context) error {
@@ -78,14 +96,27 @@ func (b *Batch) Reserve(ctx context.Context) error {
 	return nil
 }
 
+// Available returns an error unless seats of the batch can be sold now: the
+// batch must be published, its sales open and, for a batch with limited
+// seats, seats left before its end.
 func (b *Batch) Available(ctx context.Context) error {
+	if b.Status != BatchStatusPublished {
+		return ErrClassNotAvailableForSale
+	}
+	now := time.Now()
+	if b.SalesOpenAt.Valid && now.Before(b.SalesOpenAt.Time) {
+		return ErrSalesNotOpen
+	}
+	if b.SalesCloseAt.Valid && !now.Before(b.SalesCloseAt.Time) {
+		return ErrSalesClosed
+	}
 	if b.MaxSeats <= 0 {
 		return nil
 	}
 	if b.AvailableSeats == 0 {
 		return ErrClassSoldOut
 	}
-	if b.EndDate.Valid && time.Now().After(b.EndDate.Time) {
+	if b.EndDate.Valid && now.After(b.EndDate.Time) {
 		return ErrClassNotAvailableForSale
 	}
 	return nil
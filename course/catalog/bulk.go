@@ -0,0 +1,445 @@
+package catalog
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/imrenagicom/demo-app/pkg/apiclient/course/v1"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Format is the file format of bulk imports and exports.
+type Format string
+
+const (
+	FormatCSV    Format = "csv"
+	FormatJSON   Format = "json"
+	FormatNDJSON Format = "ndjson"
+)
+
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(s)); f {
+	case FormatCSV, FormatJSON, FormatNDJSON:
+		return f, nil
+	case "jsonl":
+		return FormatNDJSON, nil
+	}
+	return "", fmt.Errorf("unsupported format %q, must be one of csv, json or ndjson", s)
+}
+
+// FormatFromPath returns the format matching the extension of the file.
+func FormatFromPath(path string) (Format, error) {
+	return ParseFormat(strings.TrimPrefix(filepath.Ext(path), "."))
+}
+
+// RowError is a record which can not be imported. Row is the position of the
+// record, or the line number for CSV files.
+type RowError struct {
+	Row     int64
+	Slug    string
+	Message string
+}
+
+func (e RowError) Error() string {
+	if e.Slug != "" {
+		return fmt.Sprintf("row %d (%s): %s", e.Row, e.Slug, e.Message)
+	}
+	return fmt.Sprintf("row %d: %s", e.Row, e.Message)
+}
+
+func (e RowError) ApiV1() *v1.ImportError {
+	return &v1.ImportError{Row: e.Row, Slug: e.Slug, Message: e.Message}
+}
+
+// RecordReader reads the courses of an import one by one. Read returns
+// io.EOF after the last record. A RowError is returned for a record which
+// can not be decoded, the following records can still be read.
+type RecordReader interface {
+	Read() (*v1.CourseRecord, int64, error)
+}
+
+// RecordWriter writes the courses of an export. Close must be called after
+// the last record.
+type RecordWriter interface {
+	Write(rec *v1.CourseRecord) error
+	Close() error
+}
+
+func NewRecordReader(format Format, r io.Reader) RecordReader {
+	switch format {
+	case FormatCSV:
+		return newCSVReader(r)
+	case FormatJSON:
+		return &jsonReader{dec: json.NewDecoder(r)}
+	default:
+		s := bufio.NewScanner(r)
+		s.Buffer(make([]byte, 64*1024), 16*1024*1024)
+		return &ndjsonReader{s: s}
+	}
+}
+
+func NewRecordWriter(format Format, w io.Writer) RecordWriter {
+	switch format {
+	case FormatCSV:
+		return &csvWriter{w: csv.NewWriter(w)}
+	case FormatJSON:
+		return &jsonWriter{w: w}
+	default:
+		return &ndjsonWriter{w: w}
+	}
+}
+
+var (
+	recordUnmarshal = protojson.UnmarshalOptions{}
+	recordMarshal   = protojson.MarshalOptions{UseProtoNames: true}
+)
+
+type jsonReader struct {
+	dec     *json.Decoder
+	started bool
+	row     int64
+}
+
+func (r *jsonReader) Read() (*v1.CourseRecord, int64, error) {
+	if !r.started {
+		tok, err := r.dec.Token()
+		if err != nil {
+			return nil, 0, err
+		}
+		if d, ok := tok.(json.Delim); !ok || d != '[' {
+			return nil, 0, errors.New("json import must be an array of courses")
+		}
+		r.started = true
+	}
+	if !r.dec.More() {
+		if _, err := r.dec.Token(); err != nil {
+			return nil, 0, err
+		}
+		return nil, 0, io.EOF
+	}
+
+	r.row++
+	var raw json.RawMessage
+	if err := r.dec.Decode(&raw); err != nil {
+		return nil, r.row, err
+	}
+	rec := &v1.CourseRecord{}
+	if err := recordUnmarshal.Unmarshal(raw, rec); err != nil {
+		return nil, r.row, RowError{Row: r.row, Message: err.Error()}
+	}
+	return rec, r.row, nil
+}
+
+type ndjsonReader struct {
+	s   *bufio.Scanner
+	row int64
+}
+
+func (r *ndjsonReader) Read() (*v1.CourseRecord, int64, error) {
+	for r.s.Scan() {
+		r.row++
+		line := bytes.TrimSpace(r.s.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		rec := &v1.CourseRecord{}
+		if err := recordUnmarshal.Unmarshal(line, rec); err != nil {
+			return nil, r.row, RowError{Row: r.row, Message: err.Error()}
+		}
+		return rec, r.row, nil
+	}
+	if err := r.s.Err(); err != nil {
+		return nil, r.row, err
+	}
+	return nil, r.row, io.EOF
+}
+
+type jsonWriter struct {
+	w     io.Writer
+	count int
+}
+
+func (w *jsonWriter) Write(rec *v1.CourseRecord) error {
+	b, err := recordMarshal.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	sep := ",\n"
+	if w.count == 0 {
+		sep = "[\n"
+	}
+	w.count++
+	_, err = fmt.Fprintf(w.w, "%s%s", sep, b)
+	return err
+}
+
+func (w *jsonWriter) Close() error {
+	if w.count == 0 {
+		_, err := io.WriteString(w.w, "[]\n")
+		return err
+	}
+	_, err := io.WriteString(w.w, "\n]\n")
+	return err
+}
+
+type ndjsonWriter struct {
+	w io.Writer
+}
+
+func (w *ndjsonWriter) Write(rec *v1.CourseRecord) error {
+	b, err := recordMarshal.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w.w, "%s\n", b)
+	return err
+}
+
+func (w *ndjsonWriter) Close() error {
+	return nil
+}
+
+// csvColumns are the columns of CSV files. Every row is a batch together
+// with its course. Consecutive rows of the same course are merged into one
+// course. A course without batches is a row with empty batch columns.
+var csvColumns = []string{
+	"course", "course_name", "description", "course_status",
+	"batch", "batch_name", "max_seats", "price", "currency", "start_date", "end_date",
+	"batch_status", "sales_open_at", "sales_close_at",
+}
+
+type csvReader struct {
+	r    *csv.Reader
+	cols map[string]int
+
+	// next is the first row of the following course.
+	next     []string
+	nextLine int64
+}
+
+func newCSVReader(r io.Reader) *csvReader {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+	return &csvReader{r: cr}
+}
+
+func (r *csvReader) readHeader() error {
+	header, err := r.r.Read()
+	if err != nil {
+		return err
+	}
+	r.cols = make(map[string]int)
+	for i, h := range header {
+		h = strings.ToLower(strings.TrimSpace(h))
+		if !isCSVColumn(h) {
+			return fmt.Errorf("unknown csv column %q", h)
+		}
+		r.cols[h] = i
+	}
+	if _, ok := r.cols["course"]; !ok {
+		return errors.New("csv column course is required")
+	}
+	return nil
+}
+
+func isCSVColumn(name string) bool {
+	for _, c := range csvColumns {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *csvReader) readRow() ([]string, int64, error) {
+	row, err := r.r.Read()
+	if err != nil {
+		return nil, 0, err
+	}
+	line, _ := r.r.FieldPos(0)
+	return row, int64(line), nil
+}
+
+func (r *csvReader) value(row []string, col string) string {
+	i, ok := r.cols[col]
+	if !ok || i >= len(row) {
+		return ""
+	}
+	return strings.TrimSpace(row[i])
+}
+
+func (r *csvReader) Read() (*v1.CourseRecord, int64, error) {
+	if r.cols == nil {
+		if err := r.readHeader(); err != nil {
+			return nil, 0, err
+		}
+	}
+	if r.next == nil {
+		row, line, err := r.readRow()
+		if err != nil {
+			return nil, line, err
+		}
+		r.next, r.nextLine = row, line
+	}
+
+	row, line := r.next, r.nextLine
+	r.next = nil
+	rec := &v1.CourseRecord{
+		Slug:        r.value(row, "course"),
+		DisplayName: r.value(row, "course_name"),
+		Description: r.value(row, "description"),
+		Status:      r.value(row, "course_status"),
+	}
+	var rowErr error
+	addBatch := func(row []string, line int64) {
+		b, err := r.batchRecord(row)
+		if err != nil {
+			if rowErr == nil {
+				rowErr = RowError{Row: line, Slug: rec.Slug, Message: err.Error()}
+			}
+			return
+		}
+		if b != nil {
+			rec.Batches = append(rec.Batches, b)
+		}
+	}
+	addBatch(row, line)
+
+	for {
+		next, nextLine, err := r.readRow()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nextLine, err
+		}
+		if r.value(next, "course") != rec.Slug {
+			r.next, r.nextLine = next, nextLine
+			break
+		}
+		addBatch(next, nextLine)
+	}
+	if rowErr != nil {
+		return nil, line, rowErr
+	}
+	return rec, line, nil
+}
+
+// batchRecord returns the batch of the row, or nil when the batch columns are empty.
+func (r *csvReader) batchRecord(row []string) (*v1.BatchRecord, error) {
+	b := &v1.BatchRecord{
+		Slug:        r.value(row, "batch"),
+		DisplayName: r.value(row, "batch_name"),
+		Currency:    r.value(row, "currency"),
+		Status:      r.value(row, "batch_status"),
+	}
+	if b.Slug == "" && b.DisplayName == "" {
+		return nil, nil
+	}
+	if v := r.value(row, "max_seats"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid max_seats %q", v)
+		}
+		b.MaxSeats = int32(n)
+	}
+	if v := r.value(row, "price"); v != "" {
+		p, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid price %q", v)
+		}
+		b.Price = p
+	}
+	for _, t := range []struct {
+		col string
+		dst **timestamppb.Timestamp
+	}{
+		{"start_date", &b.StartDate},
+		{"end_date", &b.EndDate},
+		{"sales_open_at", &b.SalesOpenAt},
+		{"sales_close_at", &b.SalesCloseAt},
+	} {
+		v := r.value(row, t.col)
+		if v == "" {
+			continue
+		}
+		ts, err := parseCSVTime(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q, must be a RFC 3339 time or a date", t.col, v)
+		}
+		*t.dst = timestamppb.New(ts)
+	}
+	return b, nil
+}
+
+func parseCSVTime(v string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.DateOnly, v)
+}
+
+type csvWriter struct {
+	w             *csv.Writer
+	headerWritten bool
+}
+
+func (w *csvWriter) Write(rec *v1.CourseRecord) error {
+	if !w.headerWritten {
+		if err := w.w.Write(csvColumns); err != nil {
+			return err
+		}
+		w.headerWritten = true
+	}
+	course := []string{rec.GetSlug(), rec.GetDisplayName(), rec.GetDescription(), rec.GetStatus()}
+	if len(rec.GetBatches()) == 0 {
+		return w.w.Write(append(course, make([]string, len(csvColumns)-len(course))...))
+	}
+	for _, b := range rec.GetBatches() {
+		row := append(append([]string{}, course...),
+			b.GetSlug(),
+			b.GetDisplayName(),
+			strconv.FormatInt(int64(b.GetMaxSeats()), 10),
+			strconv.FormatFloat(b.GetPrice(), 'f', -1, 64),
+			b.GetCurrency(),
+			formatCSVTime(b.GetStartDate()),
+			formatCSVTime(b.GetEndDate()),
+			b.GetStatus(),
+			formatCSVTime(b.GetSalesOpenAt()),
+			formatCSVTime(b.GetSalesCloseAt()),
+		)
+		if err := w.w.Write(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func formatCSVTime(t *timestamppb.Timestamp) string {
+	if t == nil {
+		return ""
+	}
+	return t.AsTime().Format(time.RFC3339)
+}
+
+func (w *csvWriter) Close() error {
+	if !w.headerWritten {
+		if err := w.w.Write(csvColumns); err != nil {
+			return err
+		}
+	}
+	w.w.Flush()
+	return w.w.Error()
+}
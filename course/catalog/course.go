@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"time"
 
+	"github.com/imrenagicom/demo-app/internal/pagination"
 	v1 "github.com/imrenagicom/demo-app/pkg/apiclient/course/v1"
 
 	"github.com/google/uuid"
@@ -29,6 +30,69 @@ type Course struct {
 	PublishedAt sql.NullTime
 	Batches     []Batch
 	Status      CourseStatus
+	Instructors []Instructor
+	Prices      []CoursePrice
+	// Categories and Tags are the slugs of the categories and tags of the course.
+	Categories []string
+	Tags       []string
+	// RatingAverage and ReviewCount summarize the approved reviews of the course.
+	RatingAverage float64
+	ReviewCount   int64
+	// PublishAt and UnpublishAt schedule the status changes done by the Scheduler.
+	PublishAt   sql.NullTime
+	UnpublishAt sql.NullTime
+
+	// BatchesNextPageToken is set when the course has more batches than the preloaded ones.
+	BatchesNextPageToken string
+	// batchFields are the only batch fields loaded. All fields are loaded when it is empty.
+	batchFields []string
+}
+
+var (
+	// DefaultCourseOrder lists the most recently published courses first.
+	DefaultCourseOrder = pagination.Order{Field: "published_at", Desc: true}
+	// CourseOrderFields are the fields courses can be ordered by.
+	CourseOrderFields = []string{"published_at", "created_at"}
+)
+
+// courseSortKey returns the sql expression courses are sorted by for the order.
+// Courses published without a publish time are sorted by their creation time.
+func courseSortKey(o pagination.Order) string {
+	if o.Field == "created_at" {
+		return "c.created_at"
+	}
+	return "COALESCE(c.published_at, c.created_at)"
+}
+
+func (c Course) sortTime(o pagination.Order) time.Time {
+	if o.Field != "created_at" && c.PublishedAt.Valid {
+		return c.PublishedAt.Time
+	}
+	return c.CreatedAt
+}
+
+// CoursePrice is the price summary of the published batches of a course in one currency.
+// The price range is maintained by the database whenever prices of the course change,
+// the cheapest available batch is found when the course is read.
+type CoursePrice struct {
+	Currency                 string
+	MinPrice                 float64
+	MaxPrice                 float64
+	CheapestAvailableBatchID uuid.NullUUID
+	CheapestAvailablePrice   sql.NullFloat64
+}
+
+func (p CoursePrice) ApiV1() *v1.PriceRange {
+	var batch string
+	if p.CheapestAvailableBatchID.Valid {
+		batch = p.CheapestAvailableBatchID.UUID.String()
+	}
+	return &v1.PriceRange{
+		Currency:               p.Currency,
+		MinPrice:               p.MinPrice,
+		MaxPrice:               p.MaxPrice,
+		CheapestAvailableBatch: batch,
+	}
 }
 
 func (c Course) ApiV1() *v1.Course {
@@ -44,13 +108,56 @@ func (c Course) ApiV1() *v1.Course {
 		Description: c.Description,
 		PublishedAt: publishedAt,
 		Batches:     c.batchesPkg(),
+		Instructors: instructorsPkg(c.Instructors),
+		Price:       c.fromPrice(),
+		PriceRanges: c.priceRangesPkg(),
+		Categories:  c.Categories,
+		Tags:        c.Tags,
+
+		RatingAverage: c.RatingAverage,
+		ReviewCount:   c.ReviewCount,
+		UnpublishAt:   timestampPb(c.UnpublishAt),
+
+		BatchesNextPageToken: c.BatchesNextPageToken,
+	}
+}
+
+// fromPrice returns the lowest price of the available batches. Prices in
+// different currencies cannot be compared, so that it returns nil when the
+// available batches are sold in more than one currency.
+func (c Course) fromPrice() *v1.Price {
+	var from *v1.Price
+	for _, p := range c.Prices {
+		if !p.CheapestAvailablePrice.Valid {
+			continue
+		}
+		if from != nil {
+			return nil
+		}
+		from = &v1.Price{
+			Value:    p.CheapestAvailablePrice.Float64,
+			Currency: p.Currency,
+		}
+	}
+	return from
+}
+
+func (c Course) priceRangesPkg() []*v1.PriceRange {
+	var prs []*v1.PriceRange
+	for _, p := range c.Prices {
+		prs = append(prs, p.ApiV1())
 	}
+	return prs
 }
 
 func (c Course) batchesPkg() []*v1.Batch {
 	var bs []*v1.Batch
 	for _, b := range c.Batches {
-		bs = append(bs, b.ApiV1())
+		pb := b.ApiV1()
+		if len(c.batchFields) > 0 {
+			pruneBatch(pb, c.batchFields)
+		}
+		bs = append(bs, pb)
 	}
 	return bs
 }
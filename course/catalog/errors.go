@@ -0,0 +1,30 @@
+package catalog
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ErrInvalidArgument struct {
+	Message string
+}
+
+func (e ErrInvalidArgument) Error() string {
+	return e.Message
+}
+
+func (e ErrInvalidArgument) GRPCStatus() *status.Status {
+	return status.New(codes.InvalidArgument, e.Error())
+}
+
+type ErrAlreadyExists struct {
+	Message string
+}
+
+func (e ErrAlreadyExists) Error() string {
+	return e.Message
+}
+
+func (e ErrAlreadyExists) GRPCStatus() *status.Status {
+	return status.New(codes.AlreadyExists, e.Error())
+}
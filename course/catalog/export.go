@@ -0,0 +1,127 @@
+package catalog
+
+import (
+	"context"
+
+	v1 "github.com/imrenagicom/demo-app/pkg/apiclient/course/v1"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+)
+
+const exportPageSize = 200
+
+// ExportCourses calls fn with every course and all its batches, ordered by
+// slug. Only courses in status are exported when it is not empty.
+func (s *Store) ExportCourses(ctx context.Context, status string, fn func(rec *v1.CourseRecord) error) error {
+	filter := sq.And{sq.Eq{"deleted_at": nil}}
+	if status != "" {
+		st, err := parseStatusName(status)
+		if err != nil {
+			return ErrInvalidArgument{Message: err.Error()}
+		}
+		filter = append(filter, sq.Eq{"status": st})
+	}
+
+	sb := sq.StatementBuilder.RunWith(s.reader(ctx))
+	last := ""
+	for {
+		query := sb.Select("id", "slug", "name", "COALESCE(description, '')", "status").
+			From("courses").
+			Where(filter).
+			Where(sq.Gt{"slug": last}).
+			OrderBy("slug").
+			Limit(exportPageSize).
+			PlaceholderFormat(sq.Dollar)
+
+		rows, err := query.QueryContext(ctx)
+		if err != nil {
+			return err
+		}
+		var ids []string
+		var records []*v1.CourseRecord
+		for rows.Next() {
+			var id string
+			var status int
+			rec := &v1.CourseRecord{}
+			if err := rows.Scan(&id, &rec.Slug, &rec.DisplayName, &rec.Description, &status); err != nil {
+				rows.Close()
+				return err
+			}
+			rec.Status = statusName(status)
+			ids = append(ids, id)
+			records = append(records, rec)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return nil
+		}
+
+		if err := s.exportBatches(ctx, ids, records); err != nil {
+			return err
+		}
+		for _, rec := range records {
+			if err := fn(rec); err != nil {
+				return err
+			}
+		}
+		if len(records) < exportPageSize {
+			return nil
+		}
+		last = records[len(records)-1].Slug
+	}
+}
+
+// exportBatches populates the batches of the records, ids are the ids of the
+// courses of the records.
+func (s *Store) exportBatches(ctx context.Context, ids []string, records []*v1.CourseRecord) error {
+	byID := make(map[string]*v1.CourseRecord, len(ids))
+	for i, id := range ids {
+		byID[id] = records[i]
+	}
+
+	sb := sq.StatementBuilder.RunWith(s.reader(ctx))
+	query := sb.Select("course_id", "slug", "name", "max_seats", "COALESCE(price, 0)", "COALESCE(currency, '')",
+		"start_date", "end_date", "status", "sales_open_at", "sales_close_at").
+		From("course_batches").
+		Where(sq.Expr("course_id = ANY(?::uuid[])", pq.StringArray(ids))).
+		Where(sq.Eq{"deleted_at": nil}).
+		OrderBy("course_id", "created_at", "id").
+		PlaceholderFormat(sq.Dollar)
+
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var courseID string
+		var status int
+		var b Batch
+		if err := rows.Scan(&courseID, &b.Slug, &b.Name, &b.MaxSeats, &b.Price, &b.Currency,
+			&b.StartDate, &b.EndDate, &status, &b.SalesOpenAt, &b.SalesCloseAt); err != nil {
+			return err
+		}
+		rec := byID[courseID]
+		if rec == nil {
+			continue
+		}
+		rec.Batches = append(rec.Batches, &v1.BatchRecord{
+			Slug:         b.Slug,
+			DisplayName:  b.Name,
+			MaxSeats:     b.MaxSeats,
+			Price:        b.Price,
+			Currency:     b.Currency,
+			StartDate:    timestampPb(b.StartDate),
+			EndDate:      timestampPb(b.EndDate),
+			Status:       statusName(status),
+			SalesOpenAt:  timestampPb(b.SalesOpenAt),
+			SalesCloseAt: timestampPb(b.SalesCloseAt),
+		})
+	}
+	return rows.Err()
+}
@@ -0,0 +1,373 @@
+package catalog
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	v1 "github.com/imrenagicom/demo-app/pkg/apiclient/course/v1"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// maxImportErrors is the maximum number of errors kept in an import report.
+// Further failures are only counted.
+const maxImportErrors = 1000
+
+type ImportOptions struct {
+	DryRun    bool
+	ChunkSize int
+}
+
+type ImportOption func(*ImportOptions)
+
+// WithImportDryRun applies the records, but rolls everything back at the end.
+func WithImportDryRun() ImportOption {
+	return func(o *ImportOptions) {
+		o.DryRun = true
+	}
+}
+
+// WithImportChunkSize commits every size records instead of running the whole
+// import in a single transaction.
+func WithImportChunkSize(size int) ImportOption {
+	return func(o *ImportOptions) {
+		if size > 0 {
+			o.ChunkSize = size
+		}
+	}
+}
+
+type ImportReport struct {
+	Total          int64      `json:"total"`
+	CoursesCreated int64      `json:"courses_created"`
+	CoursesUpdated int64      `json:"courses_updated"`
+	BatchesCreated int64      `json:"batches_created"`
+	BatchesUpdated int64      `json:"batches_updated"`
+	Failed         int64      `json:"failed"`
+	Errors         []RowError `json:"errors"`
+	DryRun         bool       `json:"dry_run"`
+	// Committed is false when nothing has been committed, either because of
+	// a dry run or because a record failed in a single transaction import.
+	Committed bool `json:"committed"`
+}
+
+func (r *ImportReport) fail(err RowError) {
+	r.Failed++
+	if len(r.Errors) < maxImportErrors {
+		r.Errors = append(r.Errors, err)
+	}
+}
+
+func (r ImportReport) ApiV1() *v1.ImportCoursesResponse {
+	res := &v1.ImportCoursesResponse{
+		Total:          r.Total,
+		CoursesCreated: r.CoursesCreated,
+		CoursesUpdated: r.CoursesUpdated,
+		BatchesCreated: r.BatchesCreated,
+		BatchesUpdated: r.BatchesUpdated,
+		Failed:         r.Failed,
+		Committed:      r.Committed,
+	}
+	for _, e := range r.Errors {
+		res.Errors = append(res.Errors, e.ApiV1())
+	}
+	return res
+}
+
+var statusNames = []string{"draft", "published", "archived"}
+
+// parseStatusName returns the status named s, draft when s is empty.
+func parseStatusName(s string) (int, error) {
+	if s == "" {
+		return 0, nil
+	}
+	for i, n := range statusNames {
+		if strings.EqualFold(s, n) {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid status %q, must be one of %s", s, strings.Join(statusNames, ", "))
+}
+
+func statusName(status int) string {
+	if status >= 0 && status < len(statusNames) {
+		return statusNames[status]
+	}
+	return ""
+}
+
+// CourseFromRecord validates the record and returns the course it describes.
+func CourseFromRecord(rec *v1.CourseRecord) (*Course, error) {
+	now := time.Now()
+	c := &Course{
+		ID:          uuid.New(),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		Name:        strings.TrimSpace(rec.GetDisplayName()),
+		Slug:        strings.TrimSpace(rec.GetSlug()),
+		Description: strings.TrimSpace(rec.GetDescription()),
+	}
+	if c.Slug == "" || slugify(c.Slug) != c.Slug {
+		return nil, ErrInvalidArgument{Message: "course slug must be lower case letters, digits and dashes"}
+	}
+	if c.Name == "" {
+		return nil, ErrInvalidArgument{Message: "course display_name is required"}
+	}
+	status, err := parseStatusName(rec.GetStatus())
+	if err != nil {
+		return nil, ErrInvalidArgument{Message: "course " + err.Error()}
+	}
+	c.Status = CourseStatus(status)
+	if c.Status == CourseStatusPublished {
+		c.PublishedAt = sql.NullTime{Time: now, Valid: true}
+	}
+
+	seen := make(map[string]bool)
+	for i, br := range rec.GetBatches() {
+		b, err := batchFromRecord(br, now)
+		if err != nil {
+			return nil, ErrInvalidArgument{Message: fmt.Sprintf("batch %d: %s", i+1, err)}
+		}
+		if seen[b.Slug] {
+			return nil, ErrInvalidArgument{Message: fmt.Sprintf("batch %s is listed twice", b.Slug)}
+		}
+		seen[b.Slug] = true
+		c.Batches = append(c.Batches, *b)
+	}
+	return c, nil
+}
+
+func batchFromRecord(rec *v1.BatchRecord, now time.Time) (*Batch, error) {
+	b := &Batch{
+		ID:           uuid.New(),
+		CreatedAt:    now,
+		UpdatedAt:    now,
+		Name:         strings.TrimSpace(rec.GetDisplayName()),
+		Slug:         strings.TrimSpace(rec.GetSlug()),
+		MaxSeats:     rec.GetMaxSeats(),
+		Price:        rec.GetPrice(),
+		Currency:     strings.ToUpper(strings.TrimSpace(rec.GetCurrency())),
+		StartDate:    nullTime(rec.GetStartDate()),
+		EndDate:      nullTime(rec.GetEndDate()),
+		SalesOpenAt:  nullTime(rec.GetSalesOpenAt()),
+		SalesCloseAt: nullTime(rec.GetSalesCloseAt()),
+	}
+	if b.Slug == "" {
+		b.Slug = slugify(b.Name)
+	}
+	switch {
+	case b.Slug == "" || slugify(b.Slug) != b.Slug:
+		return nil, errors.New("slug must be lower case letters, digits and dashes")
+	case b.Name == "":
+		return nil, errors.New("display_name is required")
+	case b.MaxSeats < 0:
+		return nil, errors.New("max_seats must not be negative")
+	case b.Price < 0:
+		return nil, errors.New("price must not be negative")
+	case len(b.Currency) != 3:
+		return nil, errors.New("currency must be a 3 letter ISO 4217 code")
+	case b.StartDate.Valid && b.EndDate.Valid && b.EndDate.Time.Before(b.StartDate.Time):
+		return nil, errors.New("end_date must not be before start_date")
+	}
+	if err := (Schedule{SalesOpenAt: b.SalesOpenAt, SalesCloseAt: b.SalesCloseAt}).Validate(); err != nil {
+		return nil, err
+	}
+	status, err := parseStatusName(rec.GetStatus())
+	if err != nil {
+		return nil, err
+	}
+	b.Status = BatchStatus(status)
+	b.AvailableSeats = b.MaxSeats
+	return b, nil
+}
+
+// ImportCourses upserts the courses read from r and their batches by slug.
+// Batches missing from a record are left untouched. Every record is applied
+// in its own savepoint, so a failing record does not abort the others.
+func (s *Store) ImportCourses(ctx context.Context, r RecordReader, opts ...ImportOption) (*ImportReport, error) {
+	options := &ImportOptions{}
+	for _, o := range opts {
+		o(options)
+	}
+
+	report := &ImportReport{DryRun: options.DryRun}
+	var tx *sqlx.Tx
+	pending := 0
+	// finish ends the current transaction. A single transaction import is
+	// rolled back as soon as one record failed.
+	finish := func() error {
+		if tx == nil {
+			return nil
+		}
+		defer func() { tx = nil; pending = 0 }()
+		if options.DryRun || (options.ChunkSize == 0 && report.Failed > 0) {
+			return tx.Rollback()
+		}
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+		report.Committed = true
+		return nil
+	}
+
+	for {
+		rec, row, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		var rowErr RowError
+		if errors.As(err, &rowErr) {
+			report.Total++
+			report.fail(rowErr)
+			continue
+		}
+		if err != nil {
+			if tx != nil {
+				tx.Rollback()
+			}
+			return nil, err
+		}
+
+		report.Total++
+		c, err := CourseFromRecord(rec)
+		if err != nil {
+			report.fail(RowError{Row: row, Slug: rec.GetSlug(), Message: err.Error()})
+			continue
+		}
+
+		if tx == nil {
+			if tx, err = s.db.BeginTxx(ctx, nil); err != nil {
+				return nil, err
+			}
+		}
+		if err := s.importCourse(ctx, tx, rec, c, report); err != nil {
+			if ctx.Err() != nil {
+				tx.Rollback()
+				return nil, ctx.Err()
+			}
+			report.fail(RowError{Row: row, Slug: c.Slug, Message: err.Error()})
+			continue
+		}
+
+		pending++
+		if options.ChunkSize > 0 && pending >= options.ChunkSize {
+			if err := finish(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := finish(); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// importCourse upserts the course of rec and its batches within a savepoint
+// of tx.
+func (s *Store) importCourse(ctx context.Context, tx *sqlx.Tx, rec *v1.CourseRecord, c *Course, report *ImportReport) error {
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT import_course"); err != nil {
+		return err
+	}
+	stats, err := s.upsertCourse(ctx, tx, rec, c)
+	if err != nil {
+		if _, rbErr := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT import_course"); rbErr != nil {
+			return rbErr
+		}
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT import_course"); err != nil {
+		return err
+	}
+
+	if stats.courseCreated {
+		report.CoursesCreated++
+	} else {
+		report.CoursesUpdated++
+	}
+	report.BatchesCreated += stats.batchesCreated
+	report.BatchesUpdated += stats.batchesUpdated
+	return nil
+}
+
+type upsertStats struct {
+	courseCreated  bool
+	batchesCreated int64
+	batchesUpdated int64
+}
+
+// importedStatus is the status of a record updating an existing row, null
+// when the record has no status so that the row keeps its status.
+func importedStatus(name string, status int) sql.NullInt32 {
+	return sql.NullInt32{Int32: int32(status), Valid: name != ""}
+}
+
+// upsertCourse inserts or updates the course c of rec. New rows get the
+// defaults of the fields missing from rec, while existing rows keep their
+// status and dates.
+func (s *Store) upsertCourse(ctx context.Context, tx *sqlx.Tx, rec *v1.CourseRecord, c *Course) (*upsertStats, error) {
+	sb := sq.StatementBuilder.RunWith(tx)
+	stats := &upsertStats{}
+
+	// xmax is 0 for rows inserted by the statement. published_at is kept
+	// when an already published course is imported again.
+	upsertCourse := sb.Insert("courses").
+		Columns("id", "name", "slug", "description", "status", "published_at", "created_at", "updated_at").
+		Values(c.ID, c.Name, c.Slug, c.Description, c.Status, c.PublishedAt, c.CreatedAt, c.UpdatedAt).
+		Suffix(`ON CONFLICT (slug) DO UPDATE SET
+			name = EXCLUDED.name,
+			description = EXCLUDED.description,
+			status = COALESCE(?::int, courses.status),
+			published_at = COALESCE(courses.published_at, EXCLUDED.published_at),
+			updated_at = EXCLUDED.updated_at
+		WHERE courses.deleted_at IS NULL
+		RETURNING id, (xmax = 0)`, importedStatus(rec.GetStatus(), int(c.Status))).
+		PlaceholderFormat(sq.Dollar)
+	err := upsertCourse.QueryRowContext(ctx).Scan(&c.ID, &stats.courseCreated)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrInvalidArgument{Message: fmt.Sprintf("slug %s belongs to a deleted course", c.Slug)}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for i, b := range c.Batches {
+		// seats already taken stay taken when max_seats changes.
+		upsertBatch := sb.Insert("course_batches").
+			Columns("id", "course_id", "name", "slug", "max_seats", "available_seats", "price", "currency",
+				"start_date", "end_date", "status", "sales_open_at", "sales_close_at", "created_at", "updated_at").
+			Values(b.ID, c.ID, b.Name, b.Slug, b.MaxSeats, b.AvailableSeats, b.Price, b.Currency,
+				b.StartDate, b.EndDate, b.Status, b.SalesOpenAt, b.SalesCloseAt, b.CreatedAt, b.UpdatedAt).
+			Suffix(`ON CONFLICT (course_id, slug) WHERE deleted_at IS NULL DO UPDATE SET
+				name = EXCLUDED.name,
+				available_seats = GREATEST(course_batches.available_seats + EXCLUDED.max_seats - course_batches.max_seats, 0),
+				max_seats = EXCLUDED.max_seats,
+				price = EXCLUDED.price,
+				currency = EXCLUDED.currency,
+				start_date = COALESCE(EXCLUDED.start_date, course_batches.start_date),
+				end_date = COALESCE(EXCLUDED.end_date, course_batches.end_date),
+				status = COALESCE(?::int, course_batches.status),
+				sales_open_at = COALESCE(EXCLUDED.sales_open_at, course_batches.sales_open_at),
+				sales_close_at = COALESCE(EXCLUDED.sales_close_at, course_batches.sales_close_at),
+				updated_at = EXCLUDED.updated_at,
+				version = course_batches.version + 1
+			RETURNING (xmax = 0)`, importedStatus(rec.GetBatches()[i].GetStatus(), int(b.Status))).
+			PlaceholderFormat(sq.Dollar)
+		var created bool
+		if err := upsertBatch.QueryRowContext(ctx).Scan(&created); err != nil {
+			return nil, err
+		}
+		if created {
+			stats.batchesCreated++
+		} else {
+			stats.batchesUpdated++
+		}
+	}
+	return stats, nil
+}
@@ -0,0 +1,374 @@
+package catalog
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/imrenagicom/demo-app/internal/db"
+	"github.com/imrenagicom/demo-app/internal/pagination"
+	v1 "github.com/imrenagicom/demo-app/pkg/apiclient/course/v1"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func NewInstructor(name, imageURL, bio string) *Instructor {
+	return &Instructor{
+		ID:        uuid.New(),
+		Name:      strings.TrimSpace(name),
+		ImageURL:  strings.TrimSpace(imageURL),
+		Bio:       strings.TrimSpace(bio),
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
+
+func InstructorFromApiV1(i *v1.Instructor) *Instructor {
+	return NewInstructor(i.GetName(), i.GetImageUrl(), i.GetBio())
+}
+
+type Instructor struct {
+	ID        uuid.UUID
+	Name      string
+	ImageURL  string
+	Bio       string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt sql.NullTime
+	Version   int64
+
+	// Roles of the instructor in a course or batch. It is only loaded
+	// together with the course or batch.
+	Roles []string
+}
+
+func (i *Instructor) Validate() error {
+	if i.Name == "" {
+		return ErrInvalidArgument{Message: "instructor name is required"}
+	}
+	if i.ImageURL != "" {
+		u, err := url.ParseRequestURI(i.ImageURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return ErrInvalidArgument{Message: "instructor image_url must be an absolute http(s) url"}
+		}
+	}
+	return nil
+}
+
+// Update replaces the instructor fields listed in paths with the values from the given instructor.
+// All updatable fields are replaced when paths is empty.
+func (i *Instructor) Update(from *v1.Instructor, paths []string) error {
+	if len(paths) == 0 {
+		paths = []string{"name", "image_url", "bio"}
+	}
+	for _, p := range paths {
+		switch p {
+		case "name":
+			i.Name = strings.TrimSpace(from.GetName())
+		case "image_url":
+			i.ImageURL = strings.TrimSpace(from.GetImageUrl())
+		case "bio":
+			i.Bio = strings.TrimSpace(from.GetBio())
+		default:
+			return ErrInvalidArgument{Message: "field " + p + " can not be updated"}
+		}
+	}
+	i.UpdatedAt = time.Now()
+	return i.Validate()
+}
+
+func (i Instructor) ApiV1() *v1.Instructor {
+	return &v1.Instructor{
+		InstructorId: i.ID.String(),
+		Name:         i.Name,
+		ImageUrl:     i.ImageURL,
+		Bio:          i.Bio,
+		Roles:        i.Roles,
+		CreatedAt:    timestamppb.New(i.CreatedAt),
+		UpdatedAt:    timestamppb.New(i.UpdatedAt),
+	}
+}
+
+func instructorsPkg(instructors []Instructor) []*v1.Instructor {
+	var is []*v1.Instructor
+	for _, i := range instructors {
+		is = append(is, i.ApiV1())
+	}
+	return is
+}
+
+var instructorColumns = []string{
+	"i.id", "i.name", "COALESCE(i.image_url, '')", "COALESCE(i.bio, '')", "i.created_at", "i.updated_at", "i.version",
+}
+
+func scanInstructor(row sq.RowScanner) (*Instructor, error) {
+	var i Instructor
+	if err := row.Scan(&i.ID, &i.Name, &i.ImageURL, &i.Bio, &i.CreatedAt, &i.UpdatedAt, &i.Version); err != nil {
+		return nil, err
+	}
+	return &i, nil
+}
+
+func (s *Store) CreateInstructor(ctx context.Context, i *Instructor) error {
+	sb := sq.StatementBuilder.RunWith(s.dbCache)
+	insertInstructor := sb.Insert("instructors").
+		Columns("id", "name", "image_url", "bio", "created_at", "updated_at").
+		Values(i.ID, i.Name, i.ImageURL, i.Bio, i.CreatedAt, i.UpdatedAt).
+		PlaceholderFormat(sq.Dollar)
+	_, err := insertInstructor.ExecContext(ctx)
+	return err
+}
+
+func (s *Store) FindInstructorByID(ctx context.Context, id string) (*Instructor, error) {
+	sb := sq.StatementBuilder.RunWith(s.reader(ctx))
+	query := sb.Select(instructorColumns...).
+		From("instructors i").
+		Where(sq.Eq{"i.id": id, "i.deleted_at": nil}).
+		PlaceholderFormat(sq.Dollar)
+
+	i, err := scanInstructor(query.QueryRowContext(ctx))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, db.ErrResourceNotFound{Message: fmt.Sprintf("instructor with id %s not found", id)}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return i, nil
+}
+
+func (s *Store) FindAllInstructors(ctx context.Context, opts ...ListOption) ([]Instructor, string, error) {
+	options := &ListOptions{
+		Limit: 10,
+	}
+	for _, o := range opts {
+		o(options)
+	}
+
+	cursor, err := pagination.Decode(options.PageToken, instructorOrder, "")
+	if err != nil {
+		return nil, "", err
+	}
+
+	sb := sq.StatementBuilder.RunWith(s.reader(ctx))
+	query := sb.Select(instructorColumns...).
+		From("instructors i").
+		Where(sq.Eq{"i.deleted_at": nil}).
+		OrderBy(instructorOrder.OrderBy("i.name", "i.id")...).
+		Limit(options.Limit + 1).
+		PlaceholderFormat(sq.Dollar)
+	if cursor != nil {
+		query = query.Where(instructorOrder.After("i.name", "i.id", cursor.Key, cursor.ID))
+	}
+
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var instructors []Instructor
+	for rows.Next() {
+		i, err := scanInstructor(rows)
+		if err != nil {
+			return nil, "", err
+		}
+		instructors = append(instructors, *i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	var nextPage string
+	if uint64(len(instructors)) > options.Limit {
+		instructors = instructors[:options.Limit]
+		last := instructors[len(instructors)-1]
+		nextPage = pagination.Encode(pagination.Cursor{
+			Order: instructorOrder.String(),
+			Key:   last.Name,
+			ID:    last.ID,
+		})
+	}
+	return instructors, nextPage, nil
+}
+
+var instructorOrder = pagination.Order{Field: "name"}
+
+func (s *Store) UpdateInstructor(ctx context.Context, i *Instructor) error {
+	sb := sq.StatementBuilder.RunWith(s.dbCache)
+	updateInstructor := sb.Update("instructors").
+		Set("name", i.Name).
+		Set("image_url", i.ImageURL).
+		Set("bio", i.Bio).
+		Set("updated_at", i.UpdatedAt).
+		Set("deleted_at", i.DeletedAt).
+		Set("version", i.Version+1).
+		Where(sq.Eq{"id": i.ID, "version": i.Version}).
+		PlaceholderFormat(sq.Dollar)
+
+	res, err := updateInstructor.ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return db.ErrNoRowUpdated
+	}
+	i.Version++
+	return nil
+}
+
+// AssignCourseInstructor links the instructor to the course with the given roles.
+// The roles are replaced when the instructor is already assigned to the course.
+func (s *Store) AssignCourseInstructor(ctx context.Context, courseID, instructorID uuid.UUID, roles []string) error {
+	sb := sq.StatementBuilder.RunWith(s.dbCache)
+	source := sq.Select("c.id", "i.id").
+		Column(sq.Expr("?::varchar[]", pq.StringArray(roles))).
+		From("courses c, instructors i").
+		Where(sq.Eq{"c.id": courseID, "c.deleted_at": nil, "i.id": instructorID, "i.deleted_at": nil})
+	return s.assignInstructor(ctx, sb.Insert("course_instructors").
+		Columns("course_id", "instructor_id", "roles").
+		Select(source).
+		Suffix("ON CONFLICT (course_id, instructor_id) DO UPDATE SET roles = EXCLUDED.roles"),
+		fmt.Sprintf("course %s or instructor %s not found", courseID, instructorID),
+	)
+}
+
+// AssignBatchInstructor links the instructor to the batch of the course with the given roles.
+// The roles are replaced when the instructor is already assigned to the batch.
+func (s *Store) AssignBatchInstructor(ctx context.Context, courseID, batchID, instructorID uuid.UUID, roles []string) error {
+	sb := sq.StatementBuilder.RunWith(s.dbCache)
+	source := sq.Select("cb.id", "i.id").
+		Column(sq.Expr("?::varchar[]", pq.StringArray(roles))).
+		From("course_batches cb, instructors i").
+		Where(sq.Eq{"cb.id": batchID, "cb.course_id": courseID, "cb.deleted_at": nil, "i.id": instructorID, "i.deleted_at": nil})
+	return s.assignInstructor(ctx, sb.Insert("batch_instructors").
+		Columns("batch_id", "instructor_id", "roles").
+		Select(source).
+		Suffix("ON CONFLICT (batch_id, instructor_id) DO UPDATE SET roles = EXCLUDED.roles"),
+		fmt.Sprintf("batch %s of course %s or instructor %s not found", batchID, courseID, instructorID),
+	)
+}
+
+func (s *Store) assignInstructor(ctx context.Context, insert sq.InsertBuilder, notFoundMsg string) error {
+	res, err := insert.PlaceholderFormat(sq.Dollar).ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return db.ErrResourceNotFound{Message: notFoundMsg}
+	}
+	return nil
+}
+
+func (s *Store) UnassignCourseInstructor(ctx context.Context, courseID, instructorID uuid.UUID) error {
+	sb := sq.StatementBuilder.RunWith(s.dbCache)
+	return s.unassignInstructor(ctx, sb.Delete("course_instructors").
+		Where(sq.Eq{"course_id": courseID, "instructor_id": instructorID}),
+		fmt.Sprintf("instructor %s is not assigned to course %s", instructorID, courseID),
+	)
+}
+
+func (s *Store) UnassignBatchInstructor(ctx context.Context, courseID, batchID, instructorID uuid.UUID) error {
+	sb := sq.StatementBuilder.RunWith(s.dbCache)
+	return s.unassignInstructor(ctx, sb.Delete("batch_instructors").
+		Where(sq.Eq{"batch_id": batchID, "instructor_id": instructorID}).
+		Where("batch_id IN (SELECT id FROM course_batches WHERE course_id = ?)", courseID),
+		fmt.Sprintf("instructor %s is not assigned to batch %s of course %s", instructorID, batchID, courseID),
+	)
+}
+
+func (s *Store) unassignInstructor(ctx context.Context, del sq.DeleteBuilder, notFoundMsg string) error {
+	res, err := del.PlaceholderFormat(sq.Dollar).ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return db.ErrResourceNotFound{Message: notFoundMsg}
+	}
+	return nil
+}
+
+// loadInstructors populates the instructors of the courses and of their batches
+// using one query per link table.
+func (s *Store) loadInstructors(ctx context.Context, courses []Course) error {
+	var courseIDs, batchIDs []string
+	for _, c := range courses {
+		courseIDs = append(courseIDs, c.ID.String())
+		for _, b := range c.Batches {
+			batchIDs = append(batchIDs, b.ID.String())
+		}
+	}
+
+	byCourse, err := s.findLinkedInstructors(ctx, "course_instructors", "course_id", courseIDs)
+	if err != nil {
+		return err
+	}
+	byBatch, err := s.findLinkedInstructors(ctx, "batch_instructors", "batch_id", batchIDs)
+	if err != nil {
+		return err
+	}
+
+	for ci := range courses {
+		c := &courses[ci]
+		c.Instructors = byCourse[c.ID]
+		for bi := range c.Batches {
+			b := &c.Batches[bi]
+			b.Instructors = byBatch[b.ID]
+		}
+	}
+	return nil
+}
+
+// findLinkedInstructors returns the instructors linked through the given link
+// table, grouped by the value of the key column.
+func (s *Store) findLinkedInstructors(ctx context.Context, table, key string, ids []string) (map[uuid.UUID][]Instructor, error) {
+	res := make(map[uuid.UUID][]Instructor)
+	if len(ids) == 0 {
+		return res, nil
+	}
+
+	sb := sq.StatementBuilder.RunWith(s.reader(ctx))
+	query := sb.Select(append([]string{"l." + key, "l.roles"}, instructorColumns...)...).
+		From(table+" l").
+		Join("instructors i ON i.id = l.instructor_id").
+		Where(sq.Expr("l."+key+" = ANY(?::uuid[])", pq.StringArray(ids))).
+		Where(sq.Eq{"i.deleted_at": nil}).
+		OrderBy("l.created_at", "i.name").
+		PlaceholderFormat(sq.Dollar)
+
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id uuid.UUID
+		var roles pq.StringArray
+		var i Instructor
+		if err := rows.Scan(&id, &roles, &i.ID, &i.Name, &i.ImageURL, &i.Bio, &i.CreatedAt, &i.UpdatedAt, &i.Version); err != nil {
+			return nil, err
+		}
+		i.Roles = roles
+		res[id] = append(res[id], i)
+	}
+	return res, rows.Err()
+}
@@ -1,20 +1,44 @@
 package catalog
 
 import (
-	"encoding/base64"
-	"fmt"
+	"github.com/imrenagicom/demo-app/internal/chaos"
+	"github.com/imrenagicom/demo-app/internal/pagination"
+	"github.com/imrenagicom/demo-app/internal/postgres"
 
 	"github.com/jmoiron/sqlx"
 )
 
-type ListOptions struct {
-	Limit   uint64
-	Page    uint64
-	Preload bool
+type StoreOption func(*Store)
+
+// WithReplicas sends the reads outside of transactions to the replicas of r.
+func WithReplicas(r *postgres.Router) StoreOption {
+	return func(s *Store) {
+		s.replicas = r
+	}
+}
+
+// WithChaos injects the faults of i into the store methods, with targets
+// such as catalog.Store.ReserveSeatsInCache.
+func WithChaos(i *chaos.Injector) StoreOption {
+	return func(s *Store) {
+		s.chaos = i
+	}
 }
 
-func (f ListOptions) GetOffset() uint64 {
-	return f.Page * f.Limit
+type ListOptions struct {
+	Limit        uint64
+	PageToken    string
+	Order        pagination.Order
+	Preload      bool
+	InstructorID string
+	// CategoryRef is the slug or id of the category courses are listed from.
+	CategoryRef string
+	TagSlug     string
+
+	// BatchFields limits the preloaded batch fields. All fields are loaded when it is empty.
+	BatchFields []string
+	// BatchLimit is the maximum number of batches preloaded per course.
+	BatchLimit uint64
 }
 
 type ListOption func(*ListOptions)
@@ -33,38 +57,64 @@ func WithPreload() ListOption {
 	}
 }
 
-func WithNextPage(nextPage string) ListOption {
+// WithPreloadFields preloads batches with only the given fields of v1.Batch.
+func WithPreloadFields(fields ...string) ListOption {
 	return func(o *ListOptions) {
-		if nextPage != "" {
-			pt, _ := decode(nextPage)
-			o.Page = pt.page
+		o.Preload = true
+		o.BatchFields = append(o.BatchFields, fields...)
+	}
+}
+
+// WithBatchesPerCourse sets the maximum number of batches preloaded per course.
+func WithBatchesPerCourse(limit uint64) ListOption {
+	return func(o *ListOptions) {
+		if limit > 0 {
+			o.BatchLimit = limit
 		}
 	}
 }
 
-type pageToken struct {
-	page uint64
+// WithInstructor only lists courses taught by the instructor, either in
+// the course itself or in one of its batches.
+func WithInstructor(instructorID string) ListOption {
+	return func(o *ListOptions) {
+		o.InstructorID = instructorID
+	}
+}
+
+// WithCategory only lists courses in the category, given by slug or id, or
+// in one of its descendants.
+func WithCategory(ref string) ListOption {
+	return func(o *ListOptions) {
+		o.CategoryRef = ref
+	}
 }
 
-func (p pageToken) encode() string {
-	token := fmt.Sprintf("%d", p.page)
-	return base64.StdEncoding.EncodeToString([]byte(token))
+// WithTag only lists courses having the tag, given by slug.
+func WithTag(slug string) ListOption {
+	return func(o *ListOptions) {
+		o.TagSlug = slug
+	}
 }
 
-func decode(token string) (pageToken, error) {
-	decoded, err := base64.StdEncoding.DecodeString(token)
-	if err != nil {
-		return pageToken{}, err
+// WithNextPage continues listing after the page the token was returned with.
+// The token is verified when the list is queried.
+func WithNextPage(nextPage string) ListOption {
+	return func(o *ListOptions) {
+		o.PageToken = nextPage
 	}
-	var page uint64
-	if _, err := fmt.Sscanf(string(decoded), "%d", &page); err != nil {
-		return pageToken{}, err
+}
+
+// WithOrder sets the sort order. Only supported when listing courses.
+func WithOrder(order pagination.Order) ListOption {
+	return func(o *ListOptions) {
+		o.Order = order
 	}
-	return pageToken{page}, nil
 }
 
 type FindOptions struct {
-	Tx *sqlx.Tx
+	Tx        *sqlx.Tx
+	ForUpdate bool
 }
 
 type FindOption func(*FindOptions)
@@ -75,6 +125,14 @@ func WithFindTx(tx *sqlx.Tx) FindOption {
 	}
 }
 
+// WithFindForUpdate locks the selected rows until the transaction ends.
+// Only applicable together with WithFindTx.
+func WithFindForUpdate() FindOption {
+	return func(o *FindOptions) {
+		o.ForUpdate = true
+	}
+}
+
 type UpdateOptions struct {
 	Tx *sqlx.Tx
 }
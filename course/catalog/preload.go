@@ -0,0 +1,201 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/imrenagicom/demo-app/pkg/apiclient/course/v1"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const defaultBatchesPerCourse = 10
+
+// batchFieldColumns maps the fields of v1.Batch to the columns needed to populate them.
+var batchFieldColumns = map[string][]string{
+	"name":            {"slug"},
+	"batch_id":        nil,
+	"display_name":    {"name"},
+	"course":          nil,
+	"start_date":      {"start_date"},
+	"end_date":        {"end_date"},
+	"max_seats":       {"max_seats"},
+	"available_seats": {"available_seats"},
+	"price":           {"price", "currency"},
+	"instructors":     nil,
+	"sales_open_at":   {"sales_open_at"},
+	"sales_close_at":  {"sales_close_at"},
+	"unpublish_at":    {"unpublish_at"},
+}
+
+var (
+	// requiredBatchColumns are always selected as they identify the batch
+	// and are needed for any update of it.
+	requiredBatchColumns = []string{"id", "slug", "status", "version", "created_at"}
+	allBatchColumns      = []string{"id", "name", "slug", "max_seats", "available_seats", "price", "currency", "start_date", "end_date", "version", "status", "created_at", "sales_open_at", "sales_close_at", "unpublish_at"}
+)
+
+// ValidateBatchFields returns an error when one of the fields is not a field of v1.Batch.
+func ValidateBatchFields(fields []string) error {
+	for _, f := range fields {
+		if _, ok := batchFieldColumns[f]; !ok {
+			return ErrInvalidArgument{Message: fmt.Sprintf("unknown batch field %s", f)}
+		}
+	}
+	return nil
+}
+
+// batchColumns returns the columns to select to populate the given batch
+// fields. All columns are returned when fields is empty.
+func batchColumns(fields []string) []string {
+	if len(fields) == 0 {
+		return allBatchColumns
+	}
+	cols := append([]string{}, requiredBatchColumns...)
+	seen := make(map[string]bool)
+	for _, c := range cols {
+		seen[c] = true
+	}
+	for _, f := range fields {
+		for _, c := range batchFieldColumns[f] {
+			if !seen[c] {
+				seen[c] = true
+				cols = append(cols, c)
+			}
+		}
+	}
+	return cols
+}
+
+func (b *Batch) columnDest(col string) any {
+	switch col {
+	case "id":
+		return &b.ID
+	case "name":
+		return &b.Name
+	case "slug":
+		return &b.Slug
+	case "max_seats":
+		return &b.MaxSeats
+	case "available_seats":
+		return &b.AvailableSeats
+	case "price":
+		return &b.Price
+	case "currency":
+		return &b.Currency
+	case "start_date":
+		return &b.StartDate
+	case "end_date":
+		return &b.EndDate
+	case "version":
+		return &b.Version
+	case "status":
+		return &b.Status
+	case "created_at":
+		return &b.CreatedAt
+	case "sales_open_at":
+		return &b.SalesOpenAt
+	case "sales_close_at":
+		return &b.SalesCloseAt
+	case "unpublish_at":
+		return &b.UnpublishAt
+	}
+	panic("unknown batch column " + col)
+}
+
+// loadBatches populates up to limit published batches of every course with
+// a single query. Courses having more batches get BatchesNextPageToken set,
+// which continues the listing in FindAllBatchesByCourseID.
+func (s *Store) loadBatches(ctx context.Context, courses []Course, fields []string, limit uint64) error {
+	if len(courses) == 0 {
+		return nil
+	}
+	if limit == 0 {
+		limit = defaultBatchesPerCourse
+	}
+
+	var ids []string
+	for _, c := range courses {
+		ids = append(ids, c.ID.String())
+	}
+
+	cols := batchColumns(fields)
+	inner := sq.Select().
+		From("course_batches cb").
+		Where("cb.course_id = c.id").
+		Where(sq.Eq{"cb.deleted_at": nil, "cb.status": BatchStatusPublished}).
+		OrderBy(batchOrder.OrderBy("cb.created_at", "cb.id")...).
+		Limit(limit + 1)
+	outer := sq.StatementBuilder.RunWith(s.reader(ctx)).
+		Select("c.id").
+		From("courses c")
+	for _, col := range cols {
+		inner = inner.Column("cb." + col)
+		outer = outer.Column("b." + col)
+	}
+	innerSql, innerArgs, err := inner.ToSql()
+	if err != nil {
+		return err
+	}
+	query := outer.
+		JoinClause("CROSS JOIN LATERAL ("+innerSql+") b", innerArgs...).
+		Where(sq.Expr("c.id = ANY(?::uuid[])", pq.StringArray(ids))).
+		PlaceholderFormat(sq.Dollar)
+
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	batches := make(map[uuid.UUID][]Batch)
+	for rows.Next() {
+		var courseID uuid.UUID
+		var b Batch
+		dest := []any{&courseID}
+		for _, col := range cols {
+			dest = append(dest, b.columnDest(col))
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return err
+		}
+		batches[courseID] = append(batches[courseID], b)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for i := range courses {
+		c := &courses[i]
+		bs := batches[c.ID]
+		if uint64(len(bs)) > limit {
+			bs = bs[:limit]
+			c.BatchesNextPageToken = batchesNextPage(c.ID.String(), bs[len(bs)-1])
+		}
+		for j := range bs {
+			bs[j].CourseSlug = c.Slug
+		}
+		c.Batches = bs
+		c.batchFields = fields
+	}
+	return nil
+}
+
+// pruneBatch clears the fields of b which are not listed in fields. The
+// identifiers of the batch are always kept.
+func pruneBatch(b *v1.Batch, fields []string) {
+	keep := map[protoreflect.Name]bool{"name": true, "batch_id": true}
+	for _, f := range fields {
+		keep[protoreflect.Name(f)] = true
+	}
+	m := b.ProtoReflect()
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if !keep[fd.Name()] {
+			m.Clear(fd)
+		}
+		return true
+	})
+}
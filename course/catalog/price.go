@@ -0,0 +1,66 @@
+package catalog
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// loadPrices populates the price summary of the courses from course_prices
+// using a single query. The cheapest available batch of every currency is
+// looked up at read time, as seats and end dates change without refreshing
+// course_prices.
+func (s *Store) loadPrices(ctx context.Context, courses []Course) error {
+	if len(courses) == 0 {
+		return nil
+	}
+	var ids []string
+	for _, c := range courses {
+		ids = append(ids, c.ID.String())
+	}
+
+	sb := sq.StatementBuilder.RunWith(s.reader(ctx))
+	query := sb.Select("cp.course_id", "cp.currency", "cp.min_price", "cp.max_price", "a.id", "a.price").
+		From("course_prices cp").
+		JoinClause(`LEFT JOIN LATERAL (
+			SELECT cb.id, cb.price
+			FROM course_batches cb
+			WHERE cb.course_id = cp.course_id
+				AND cb.currency = cp.currency
+				AND cb.price IS NOT NULL
+				AND cb.status = ?
+				AND cb.deleted_at IS NULL
+				AND (cb.max_seats <= 0 OR cb.available_seats > 0)
+				AND (cb.end_date IS NULL OR cb.end_date > now())
+			ORDER BY cb.price, cb.start_date NULLS LAST
+			LIMIT 1) a ON true`, BatchStatusPublished).
+		Where(sq.Expr("cp.course_id = ANY(?::uuid[])", pq.StringArray(ids))).
+		OrderBy("cp.course_id", "cp.currency").
+		PlaceholderFormat(sq.Dollar)
+
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	prices := make(map[uuid.UUID][]CoursePrice)
+	for rows.Next() {
+		var id uuid.UUID
+		var p CoursePrice
+		if err := rows.Scan(&id, &p.Currency, &p.MinPrice, &p.MaxPrice, &p.CheapestAvailableBatchID, &p.CheapestAvailablePrice); err != nil {
+			return err
+		}
+		prices[id] = append(prices[id], p)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for i := range courses {
+		courses[i].Prices = prices[courses[i].ID]
+	}
+	return nil
+}
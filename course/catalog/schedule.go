@@ -0,0 +1,205 @@
+package catalog
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/imrenagicom/demo-app/internal/db"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const defaultScheduleInterval = time.Minute
+
+// Schedule is when a course or batch is published and unpublished, and for
+// batches, when its sales open and close. Unset times are not scheduled.
+type Schedule struct {
+	PublishAt    sql.NullTime
+	UnpublishAt  sql.NullTime
+	SalesOpenAt  sql.NullTime
+	SalesCloseAt sql.NullTime
+}
+
+func (s Schedule) Validate() error {
+	if s.PublishAt.Valid && s.UnpublishAt.Valid && !s.PublishAt.Time.Before(s.UnpublishAt.Time) {
+		return ErrInvalidArgument{Message: "publish_at must be before unpublish_at"}
+	}
+	if s.SalesOpenAt.Valid && s.SalesCloseAt.Valid && !s.SalesOpenAt.Time.Before(s.SalesCloseAt.Time) {
+		return ErrInvalidArgument{Message: "sales_open_at must be before sales_close_at"}
+	}
+	return nil
+}
+
+// ScheduleCourse sets the publish schedule of the course, whatever its status.
+func (s *Store) ScheduleCourse(ctx context.Context, courseID uuid.UUID, schedule Schedule) error {
+	sb := sq.StatementBuilder.RunWith(s.dbCache)
+	updateCourse := sb.Update("courses").
+		Set("publish_at", schedule.PublishAt).
+		Set("unpublish_at", schedule.UnpublishAt).
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{"id": courseID, "deleted_at": nil}).
+		PlaceholderFormat(sq.Dollar)
+	res, err := updateCourse.ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+	return expectUpdated(res, fmt.Sprintf("course with id %s not found", courseID))
+}
+
+// ScheduleBatch sets the publish schedule and the sales window of the batch, whatever its status.
+func (s *Store) ScheduleBatch(ctx context.Context, courseID, batchID uuid.UUID, schedule Schedule) error {
+	sb := sq.StatementBuilder.RunWith(s.dbCache)
+	updateBatch := sb.Update("course_batches").
+		Set("publish_at", schedule.PublishAt).
+		Set("unpublish_at", schedule.UnpublishAt).
+		Set("sales_open_at", schedule.SalesOpenAt).
+		Set("sales_close_at", schedule.SalesCloseAt).
+		Set("updated_at", time.Now()).
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"id": batchID, "course_id": courseID, "deleted_at": nil}).
+		PlaceholderFormat(sq.Dollar)
+	res, err := updateBatch.ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+	return expectUpdated(res, fmt.Sprintf("batch with id %s not found", batchID))
+}
+
+func expectUpdated(res sql.Result, notFoundMsg string) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return db.ErrResourceNotFound{Message: notFoundMsg}
+	}
+	return nil
+}
+
+// ScheduleReport lists the courses and batches whose status has been changed.
+type ScheduleReport struct {
+	CoursesPublished   []uuid.UUID
+	CoursesUnpublished []uuid.UUID
+	BatchesPublished   []uuid.UUID
+	BatchesUnpublished []uuid.UUID
+}
+
+func NewScheduler(store *Store) *Scheduler {
+	return &Scheduler{
+		store: store,
+	}
+}
+
+// Scheduler publishes draft courses and batches whose publish_at has passed
+// and turns published ones back to draft once their unpublish_at has passed.
+// The schedule is cleared when the status is changed, so a course or batch
+// can be scheduled again.
+type Scheduler struct {
+	store *Store
+}
+
+func (s *Scheduler) Apply(ctx context.Context) (*ScheduleReport, error) {
+	report := &ScheduleReport{}
+	now := time.Now()
+	var err error
+
+	// published_at is kept when a course is published again.
+	if report.CoursesPublished, err = s.flip(ctx, "courses", "publish_at", now,
+		CourseStatusDraft, CourseStatusPublished, sq.Expr("COALESCE(published_at, publish_at)")); err != nil {
+		return nil, err
+	}
+	if report.CoursesUnpublished, err = s.flip(ctx, "courses", "unpublish_at", now,
+		CourseStatusPublished, CourseStatusDraft, nil); err != nil {
+		return nil, err
+	}
+	if report.BatchesPublished, err = s.flip(ctx, "course_batches", "publish_at", now,
+		BatchStatusDraft, BatchStatusPublished, nil); err != nil {
+		return nil, err
+	}
+	if report.BatchesUnpublished, err = s.flip(ctx, "course_batches", "unpublish_at", now,
+		BatchStatusPublished, BatchStatusDraft, nil); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// flip changes the status of the rows of table in status from whose
+// scheduleColumn has passed to status to and clears the schedule.
+func (s *Scheduler) flip(ctx context.Context, table, scheduleColumn string, now time.Time, from, to any, publishedAt sq.Sqlizer) ([]uuid.UUID, error) {
+	update := sq.StatementBuilder.RunWith(s.store.db).
+		Update(table).
+		Set("status", to).
+		Set(scheduleColumn, nil).
+		Set("updated_at", now).
+		Where(sq.Eq{"status": from, "deleted_at": nil}).
+		Where(sq.LtOrEq{scheduleColumn: now}).
+		Suffix("RETURNING id").
+		PlaceholderFormat(sq.Dollar)
+	if publishedAt != nil {
+		update = update.Set("published_at", publishedAt)
+	}
+	if table == "course_batches" {
+		update = update.Set("version", sq.Expr("version + 1"))
+	}
+
+	rows, err := update.QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// Run applies the schedule every interval until ctx is done.
+func (s *Scheduler) Run(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = defaultScheduleInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			report, err := s.Apply(ctx)
+			if err != nil {
+				log.Error().Err(err).Msg("unable to apply publish schedule")
+				continue
+			}
+			for _, id := range report.CoursesPublished {
+				log.Info().Str("course_id", id.String()).Msg("scheduled course published")
+			}
+			for _, id := range report.CoursesUnpublished {
+				log.Info().Str("course_id", id.String()).Msg("scheduled course unpublished")
+			}
+			for _, id := range report.BatchesPublished {
+				log.Info().Str("batch_id", id.String()).Msg("scheduled batch published")
+			}
+			for _, id := range report.BatchesUnpublished {
+				log.Info().Str("batch_id", id.String()).Msg("scheduled batch unpublished")
+			}
+		}
+	}
+}
+
+func nullTime(t *timestamppb.Timestamp) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: t.AsTime(), Valid: true}
+}
@@ -0,0 +1,355 @@
+package catalog
+
+import (
+	"context"
+	"database/sql"
+	"html"
+	"strings"
+
+	"github.com/imrenagicom/demo-app/internal/pagination"
+	v1 "github.com/imrenagicom/demo-app/pkg/apiclient/course/v1"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+var searchOrder = pagination.Order{Field: "rank", Desc: true}
+
+// searchConfig is the postgres text search configuration used to build
+// courses.search_vector. Queries must use the same configuration.
+const searchConfig = "english"
+
+// ts_headline wraps the matches in markers from the unicode private use
+// area, which are removed from the field first. The snippet is then HTML
+// escaped and the markers replaced by <mark></mark>, so that the markup of a
+// course name or description is never returned as HTML.
+const (
+	highlightStart   = "\ue000"
+	highlightStop    = "\ue001"
+	highlightOptions = `StartSel="` + highlightStart + `", StopSel="` + highlightStop + `", MaxFragments=2, MaxWords=20, MinWords=5`
+)
+
+var highlightMarkers = strings.NewReplacer(highlightStart, "<mark>", highlightStop, "</mark>")
+
+// highlightSnippet returns the HTML snippet of a ts_headline result and
+// whether it has any match.
+func highlightSnippet(headline string) (string, bool) {
+	if !strings.Contains(headline, highlightStart) {
+		return "", false
+	}
+	return highlightMarkers.Replace(html.EscapeString(headline)), true
+}
+
+const (
+	FacetCurrency     = "currency"
+	FacetAvailability = "availability"
+	FacetStartMonth   = "start_month"
+)
+
+type SearchQuery struct {
+	Text          string
+	MinPrice      sql.NullFloat64
+	MaxPrice      sql.NullFloat64
+	Currency      string
+	StartAfter    sql.NullTime
+	StartBefore   sql.NullTime
+	AvailableOnly bool
+}
+
+func (q SearchQuery) Validate() error {
+	if q.MinPrice.Valid && q.MaxPrice.Valid && q.MinPrice.Float64 > q.MaxPrice.Float64 {
+		return ErrInvalidArgument{Message: "min_price must not be greater than max_price"}
+	}
+	if q.StartAfter.Valid && q.StartBefore.Valid && !q.StartAfter.Time.Before(q.StartBefore.Time) {
+		return ErrInvalidArgument{Message: "start_after must be before start_before"}
+	}
+	return nil
+}
+
+func (q SearchQuery) hasBatchFilter() bool {
+	return q.MinPrice.Valid || q.MaxPrice.Valid || q.Currency != "" ||
+		q.StartAfter.Valid || q.StartBefore.Valid || q.AvailableOnly
+}
+
+// batchFilter returns the conditions a batch of a matching course must meet.
+// The batch table must be aliased as cb.
+func (q SearchQuery) batchFilter() sq.And {
+	filter := sq.And{
+		sq.Eq{"cb.deleted_at": nil, "cb.status": BatchStatusPublished},
+	}
+	if q.MinPrice.Valid {
+		filter = append(filter, sq.GtOrEq{"cb.price": q.MinPrice.Float64})
+	}
+	if q.MaxPrice.Valid {
+		filter = append(filter, sq.LtOrEq{"cb.price": q.MaxPrice.Float64})
+	}
+	if q.Currency != "" {
+		filter = append(filter, sq.Eq{"cb.currency": q.Currency})
+	}
+	if q.StartAfter.Valid {
+		filter = append(filter, sq.GtOrEq{"cb.start_date": q.StartAfter.Time})
+	}
+	if q.StartBefore.Valid {
+		filter = append(filter, sq.Lt{"cb.start_date": q.StartBefore.Time})
+	}
+	if q.AvailableOnly {
+		filter = append(filter, sq.Or{sq.LtOrEq{"cb.max_seats": 0}, sq.Gt{"cb.available_seats": 0}})
+	}
+	return filter
+}
+
+type Highlight struct {
+	Field   string
+	Snippet string
+}
+
+type SearchHit struct {
+	Course     Course
+	Rank       float32
+	Highlights []Highlight
+}
+
+type FacetValue struct {
+	Value string
+	Count int64
+}
+
+type Facet struct {
+	Field  string
+	Values []FacetValue
+}
+
+type SearchResult struct {
+	Hits          []SearchHit
+	Facets        []Facet
+	Total         int64
+	NextPageToken string
+}
+
+func (r SearchResult) ApiV1() *v1.SearchCoursesResponse {
+	res := &v1.SearchCoursesResponse{
+		NextPageToken: r.NextPageToken,
+		TotalSize:     r.Total,
+	}
+	for _, h := range r.Hits {
+		hit := &v1.CourseSearchResult{
+			Course: h.Course.ApiV1(),
+			Rank:   h.Rank,
+		}
+		for _, hl := range h.Highlights {
+			hit.Highlights = append(hit.Highlights, &v1.Highlight{Field: hl.Field, Snippet: hl.Snippet})
+		}
+		res.Results = append(res.Results, hit)
+	}
+	for _, f := range r.Facets {
+		facet := &v1.Facet{Field: f.Field}
+		for _, v := range f.Values {
+			facet.Values = append(facet.Values, &v1.FacetValue{Value: v.Value, Count: v.Count})
+		}
+		res.Facets = append(res.Facets, facet)
+	}
+	return res
+}
+
+// matchingCourses selects published courses matching q together with their rank.
+func matchingCourses(q SearchQuery) (sq.SelectBuilder, error) {
+	courses := sq.
+		Select("c.id", "c.name", "c.slug", "c.description", "c.status", "c.published_at", "c.rating_average", "c.review_count").
+		From("courses c").
+		Where(sq.Eq{"c.deleted_at": nil, "c.status": CourseStatusPublished})
+
+	if q.Text != "" {
+		courses = courses.
+			Column(sq.Expr("ts_rank_cd(c.search_vector, websearch_to_tsquery(?::regconfig, ?)) AS rank", searchConfig, q.Text)).
+			Where("c.search_vector @@ websearch_to_tsquery(?::regconfig, ?)", searchConfig, q.Text)
+	} else {
+		courses = courses.Column("0::real AS rank")
+	}
+
+	if q.hasBatchFilter() {
+		batches, args, err := sq.Select("1").
+			From("course_batches cb").
+			Where("cb.course_id = c.id").
+			Where(q.batchFilter()).
+			ToSql()
+		if err != nil {
+			return sq.SelectBuilder{}, err
+		}
+		courses = courses.Where("EXISTS ("+batches+")", args...)
+	}
+	return courses, nil
+}
+
+func (s *Store) SearchCourses(ctx context.Context, q SearchQuery, opts ...ListOption) (*SearchResult, error) {
+	options := &ListOptions{
+		Limit: 10,
+	}
+	for _, o := range opts {
+		o(options)
+	}
+
+	// search results are ordered by relevance, so the cursor keeps the offset
+	// of the next page instead of a sort key.
+	filter := pagination.Fingerprint("search", q.Text, q.MinPrice, q.MaxPrice, q.Currency,
+		q.StartAfter, q.StartBefore, q.AvailableOnly)
+	cursor, err := pagination.Decode(options.PageToken, searchOrder, filter)
+	if err != nil {
+		return nil, err
+	}
+	var offset uint64
+	if cursor != nil {
+		offset = cursor.Offset
+	}
+
+	matched, err := matchingCourses(q)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &SearchResult{}
+	sb := sq.StatementBuilder.RunWith(s.reader(ctx))
+	count := sb.Select("COUNT(*)").
+		FromSelect(matched, "m").
+		PlaceholderFormat(sq.Dollar)
+	if err := count.QueryRowContext(ctx).Scan(&res.Total); err != nil {
+		return nil, err
+	}
+
+	page := matched
+	if q.Text != "" {
+		page = page.
+			Column(sq.Expr("ts_headline(?::regconfig, translate(c.name, ?, ''), websearch_to_tsquery(?::regconfig, ?), ?)",
+				searchConfig, highlightStart+highlightStop, searchConfig, q.Text, highlightOptions)).
+			Column(sq.Expr("ts_headline(?::regconfig, translate(c.description, ?, ''), websearch_to_tsquery(?::regconfig, ?), ?)",
+				searchConfig, highlightStart+highlightStop, searchConfig, q.Text, highlightOptions))
+	}
+	page = page.
+		OrderBy("rank DESC", "c.published_at DESC", "c.id").
+		Offset(offset).
+		Limit(options.Limit).
+		RunWith(s.reader(ctx)).
+		PlaceholderFormat(sq.Dollar)
+
+	rows, err := page.QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var h SearchHit
+		c := &h.Course
+		dest := []any{&c.ID, &c.Name, &c.Slug, &c.Description, &c.Status, &c.PublishedAt, &c.RatingAverage, &c.ReviewCount, &h.Rank}
+		var nameHl, descriptionHl sql.NullString
+		if q.Text != "" {
+			dest = append(dest, &nameHl, &descriptionHl)
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		if snippet, ok := highlightSnippet(nameHl.String); ok {
+			h.Highlights = append(h.Highlights, Highlight{Field: "display_name", Snippet: snippet})
+		}
+		if snippet, ok := highlightSnippet(descriptionHl.String); ok {
+			h.Highlights = append(h.Highlights, Highlight{Field: "description", Snippet: snippet})
+		}
+		res.Hits = append(res.Hits, h)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	courses := make([]Course, len(res.Hits))
+	for i := range res.Hits {
+		courses[i] = res.Hits[i].Course
+	}
+	if err := s.loadPrices(ctx, courses); err != nil {
+		return nil, err
+	}
+	for i := range res.Hits {
+		res.Hits[i].Course = courses[i]
+	}
+
+	if res.Facets, err = s.searchFacets(ctx, q, matched); err != nil {
+		return nil, err
+	}
+
+	if next := offset + uint64(len(res.Hits)); next < uint64(res.Total) {
+		res.NextPageToken = pagination.Encode(pagination.Cursor{
+			Order:  searchOrder.String(),
+			Filter: filter,
+			Offset: next,
+		})
+	}
+	return res, nil
+}
+
+// searchFacets counts the matching courses per currency, seat availability
+// and start month of their batches. Only batches meeting the filters are counted.
+func (s *Store) searchFacets(ctx context.Context, q SearchQuery, matched sq.SelectBuilder) ([]Facet, error) {
+	sb := sq.StatementBuilder.RunWith(s.reader(ctx))
+	batchFilter := q.batchFilter()
+
+	currency := sb.Select("cb.currency", "COUNT(DISTINCT m.id)").
+		FromSelect(matched, "m").
+		Join("course_batches cb ON cb.course_id = m.id").
+		Where(batchFilter).
+		GroupBy("cb.currency").
+		OrderBy("2 DESC", "1").
+		PlaceholderFormat(sq.Dollar)
+
+	perCourse := sq.Select("m.id").
+		Column("CASE WHEN bool_or(cb.max_seats <= 0 OR cb.available_seats > 0) THEN 'available' ELSE 'sold_out' END AS availability").
+		FromSelect(matched, "m").
+		Join("course_batches cb ON cb.course_id = m.id").
+		Where(batchFilter).
+		GroupBy("m.id")
+	availability := sb.Select("a.availability", "COUNT(*)").
+		FromSelect(perCourse, "a").
+		GroupBy("a.availability").
+		OrderBy("1").
+		PlaceholderFormat(sq.Dollar)
+
+	startMonth := sb.Select("to_char(cb.start_date, 'YYYY-MM')", "COUNT(DISTINCT m.id)").
+		FromSelect(matched, "m").
+		Join("course_batches cb ON cb.course_id = m.id").
+		Where(batchFilter).
+		Where(sq.NotEq{"cb.start_date": nil}).
+		GroupBy("1").
+		OrderBy("1").
+		PlaceholderFormat(sq.Dollar)
+
+	var facets []Facet
+	for _, f := range []struct {
+		field string
+		query sq.SelectBuilder
+	}{
+		{FacetCurrency, currency},
+		{FacetAvailability, availability},
+		{FacetStartMonth, startMonth},
+	} {
+		values, err := queryFacetValues(ctx, f.query)
+		if err != nil {
+			return nil, err
+		}
+		facets = append(facets, Facet{Field: f.field, Values: values})
+	}
+	return facets, nil
+}
+
+func queryFacetValues(ctx context.Context, query sq.SelectBuilder) ([]FacetValue, error) {
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var values []FacetValue
+	for rows.Next() {
+		var v FacetValue
+		if err := rows.Scan(&v.Value, &v.Count); err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+	return values, rows.Err()
+}
@@ -3,12 +3,14 @@ package catalog
 import (
 	"context"
 	"database/sql"
-	"math/rand"
+	"fmt"
+	"strings"
 	"time"
 
+	"github.com/imrenagicom/demo-app/internal/db"
+	"github.com/imrenagicom/demo-app/internal/pagination"
 	v1 "github.com/imrenagicom/demo-app/pkg/apiclient/course/v1"
 
-	"github.com/go-faker/faker/v4"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 )
@@ -26,67 +28,324 @@ type Service struct {
 }
 
 func (s Service) ListCourse(ctx context.Context, req *v1.ListCoursesRequest) ([]Course, string, error) {
+	order, err := pagination.ParseOrder(req.GetOrderBy(), DefaultCourseOrder, CourseOrderFields...)
+	if err != nil {
+		return nil, "", err
+	}
 	opts := []ListOption{
 		WithMaxResults(req.GetPageSize()),
 		WithNextPage(req.GetPageToken()),
+		WithOrder(order),
+	}
+	if req.GetInstructor() != "" {
+		if _, err := uuid.Parse(req.GetInstructor()); err != nil {
+			return nil, "", ErrInvalidArgument{Message: "instructor must be a valid instructor id"}
+		}
+		opts = append(opts, WithInstructor(req.GetInstructor()))
+	}
+	if req.GetCategory() != "" {
+		opts = append(opts, WithCategory(resourceID(req.GetCategory(), "categories")))
 	}
+	if req.GetTag() != "" {
+		opts = append(opts, WithTag(resourceID(req.GetTag(), "tags")))
+	}
+	var batchFields []string
+	preloadAll := false
 	for _, f := range req.GetListMask().GetPaths() {
-		switch f {
-		case "courses.batches":
-			opts = append(opts, WithPreload())
+		switch {
+		case f == "courses.batches":
+			preloadAll = true
+		case strings.HasPrefix(f, "courses.batches."):
+			batchFields = append(batchFields, strings.TrimPrefix(f, "courses.batches."))
 		}
 	}
+	if err := ValidateBatchFields(batchFields); err != nil {
+		return nil, "", err
+	}
+	switch {
+	case preloadAll:
+		opts = append(opts, WithPreload())
+	case len(batchFields) > 0:
+		opts = append(opts, WithPreloadFields(batchFields...))
+	}
+	opts = append(opts, WithBatchesPerCourse(req.GetBatchesPageSize()))
 	return s.store.FindAllCourse(ctx, opts...)
 }
 
 func (s Service) GetCourse(ctx context.Context, req *v1.GetCourseRequest) (*Course, error) {
-	return s.store.FindCourseByID(ctx, req.GetCourse())
-}
-
-func (s Service) Seed(ctx context.Context) error {
-	for i := 0; i < 1000; i++ {
-		start := time.Now()
-		end := start.AddDate(0, 2, 0)
-
-		// generate random number of batches
-		numBatches := rand.Intn(5) + 1
-		var batches []Batch
-		for j := 0; j < numBatches; j++ {
-			tc := Batch{
-				ID:             uuid.New(),
-				CreatedAt:      time.Now(),
-				UpdatedAt:      time.Now(),
-				Name:           faker.Name(),
-				MaxSeats:       50,
-				AvailableSeats: int32(rand.Intn(50)),
-				Status:         BatchStatusPublished,
-				Price:          float64(rand.Intn(100000)) + 100000,
-				Currency:       "IDR",
-				StartDate:      sql.NullTime{Time: start, Valid: true},
-				EndDate:        sql.NullTime{Time: end, Valid: true},
-			}
-			batches = append(batches, tc)
+	return s.store.FindCourse(ctx, req.GetCourse())
+}
+
+func (s Service) ListBatches(ctx context.Context, req *v1.ListBatchesRequest) ([]Batch, string, error) {
+	c, err := s.store.FindCourse(ctx, req.GetCourse())
+	if err != nil {
+		return nil, "", err
+	}
+	batches, nextPage, err := s.store.FindAllBatchesByCourseID(ctx, c.ID.String(),
+		WithMaxResults(req.GetPageSize()),
+		WithNextPage(req.GetPageToken()),
+	)
+	if err != nil {
+		return nil, "", err
+	}
+	for i := range batches {
+		batches[i].CourseSlug = c.Slug
+	}
+	return batches, nextPage, nil
+}
+
+func (s Service) GetBatch(ctx context.Context, req *v1.GetBatchRequest) (*Batch, error) {
+	return s.store.FindCourseBatch(ctx, req.GetCourse(), req.GetBatch())
+}
+
+func (s Service) SearchCourses(ctx context.Context, req *v1.SearchCoursesRequest) (*SearchResult, error) {
+	q := SearchQuery{
+		Text:          strings.TrimSpace(req.GetQuery()),
+		Currency:      req.GetCurrency(),
+		AvailableOnly: req.GetAvailableOnly(),
+	}
+	if req.MinPrice != nil {
+		q.MinPrice = sql.NullFloat64{Float64: req.GetMinPrice(), Valid: true}
+	}
+	if req.MaxPrice != nil {
+		q.MaxPrice = sql.NullFloat64{Float64: req.GetMaxPrice(), Valid: true}
+	}
+	if req.GetStartAfter() != nil {
+		q.StartAfter = sql.NullTime{Time: req.GetStartAfter().AsTime(), Valid: true}
+	}
+	if req.GetStartBefore() != nil {
+		q.StartBefore = sql.NullTime{Time: req.GetStartBefore().AsTime(), Valid: true}
+	}
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+	return s.store.SearchCourses(ctx, q,
+		WithMaxResults(req.GetPageSize()),
+		WithNextPage(req.GetPageToken()),
+	)
+}
+
+func (s Service) ImportCourses(ctx context.Context, r RecordReader, opts ...ImportOption) (*ImportReport, error) {
+	return s.store.ImportCourses(ctx, r, opts...)
+}
+
+func (s Service) ExportCourses(ctx context.Context, req *v1.ExportCoursesRequest, fn func(rec *v1.CourseRecord) error) error {
+	return s.store.ExportCourses(ctx, req.GetStatus(), fn)
+}
+
+func (s Service) ScheduleCourse(ctx context.Context, req *v1.ScheduleCourseRequest) error {
+	courseID, err := s.store.resolveCourseID(ctx, resourceID(req.GetCourse(), "courses"))
+	if err != nil {
+		return err
+	}
+	schedule := Schedule{
+		PublishAt:   nullTime(req.GetPublishAt()),
+		UnpublishAt: nullTime(req.GetUnpublishAt()),
+	}
+	if err := schedule.Validate(); err != nil {
+		return err
+	}
+	return s.store.ScheduleCourse(ctx, courseID, schedule)
+}
+
+func (s Service) ScheduleBatch(ctx context.Context, req *v1.ScheduleBatchRequest) error {
+	courseID, err := s.store.resolveCourseID(ctx, resourceID(req.GetCourse(), "courses"))
+	if err != nil {
+		return err
+	}
+	batchID, err := s.store.resolveBatchID(ctx, courseID, resourceID(req.GetBatch(), "batches"))
+	if err != nil {
+		return err
+	}
+	schedule := Schedule{
+		PublishAt:    nullTime(req.GetPublishAt()),
+		UnpublishAt:  nullTime(req.GetUnpublishAt()),
+		SalesOpenAt:  nullTime(req.GetSalesOpenAt()),
+		SalesCloseAt: nullTime(req.GetSalesCloseAt()),
+	}
+	if err := schedule.Validate(); err != nil {
+		return err
+	}
+	return s.store.ScheduleBatch(ctx, courseID, batchID, schedule)
+}
+
+func (s Service) ListCategories(ctx context.Context, req *v1.ListCategoriesRequest) ([]Category, error) {
+	var parent *Category
+	if req.GetParent() != "" {
+		var err error
+		if parent, err = s.store.FindCategory(ctx, resourceID(req.GetParent(), "categories")); err != nil {
+			return nil, err
+		}
+	}
+	return s.store.FindAllCategories(ctx, parent, req.GetRecursive())
+}
+
+func (s Service) CreateCategory(ctx context.Context, req *v1.CreateCategoryRequest) (*Category, error) {
+	var parent *Category
+	if ref := req.GetCategory().GetParent(); ref != "" {
+		var err error
+		if parent, err = s.store.FindCategory(ctx, resourceID(ref, "categories")); err != nil {
+			return nil, err
+		}
+	}
+	c := NewCategory(req.GetCategory().GetDisplayName(), req.GetCategory().GetDescription(), parent)
+	if req.GetCategory().GetName() != "" {
+		c.Slug = req.GetCategory().GetName()
+	}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.store.CreateCategory(ctx, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (s Service) ListTags(ctx context.Context, req *v1.ListTagsRequest) ([]Tag, error) {
+	limit := req.GetPageSize()
+	if limit == 0 {
+		limit = 50
+	}
+	return s.store.FindAllTags(ctx, req.GetMinCourseCount(), limit)
+}
+
+// AssignCourseCategories replaces the categories of the published course.
+func (s Service) AssignCourseCategories(ctx context.Context, req *v1.AssignCourseCategoriesRequest) (*Course, error) {
+	c, err := s.store.FindCourse(ctx, req.GetCourse())
+	if err != nil {
+		return nil, err
+	}
+	var ids []uuid.UUID
+	for _, ref := range req.GetCategories() {
+		id, err := s.store.findCategoryID(ctx, resourceID(ref, "categories"))
+		if err != nil {
+			return nil, err
 		}
+		ids = append(ids, id)
+	}
+	if err := s.store.SetCourseCategories(ctx, c.ID, ids); err != nil {
+		return nil, err
+	}
+	return s.store.FindCourseByID(ctx, c.ID.String())
+}
+
+// AssignCourseTags replaces the tags of the published course.
+func (s Service) AssignCourseTags(ctx context.Context, req *v1.AssignCourseTagsRequest) (*Course, error) {
+	c, err := s.store.FindCourse(ctx, req.GetCourse())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.store.SetCourseTags(ctx, c.ID, req.GetTags()); err != nil {
+		return nil, err
+	}
+	return s.store.FindCourseByID(ctx, c.ID.String())
+}
+
+func (s Service) CreateInstructor(ctx context.Context, req *v1.CreateInstructorRequest) (*Instructor, error) {
+	i := InstructorFromApiV1(req.GetInstructor())
+	if err := i.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.store.CreateInstructor(ctx, i); err != nil {
+		return nil, err
+	}
+	return i, nil
+}
+
+func (s Service) GetInstructor(ctx context.Context, req *v1.GetInstructorRequest) (*Instructor, error) {
+	if _, err := uuid.Parse(req.GetInstructor()); err != nil {
+		return nil, db.ErrResourceNotFound{Message: fmt.Sprintf("instructor with id %s not found", req.GetInstructor())}
+	}
+	return s.store.FindInstructorByID(ctx, req.GetInstructor())
+}
+
+func (s Service) ListInstructors(ctx context.Context, req *v1.ListInstructorsRequest) ([]Instructor, string, error) {
+	return s.store.FindAllInstructors(ctx,
+		WithMaxResults(req.GetPageSize()),
+		WithNextPage(req.GetPageToken()),
+	)
+}
+
+func (s Service) UpdateInstructor(ctx context.Context, req *v1.UpdateInstructorRequest) (*Instructor, error) {
+	i, err := s.GetInstructor(ctx, &v1.GetInstructorRequest{Instructor: req.GetInstructor().GetInstructorId()})
+	if err != nil {
+		return nil, err
+	}
+	if err := i.Update(req.GetInstructor(), req.GetUpdateMask().GetPaths()); err != nil {
+		return nil, err
+	}
+	if err := s.store.UpdateInstructor(ctx, i); err != nil {
+		return nil, err
+	}
+	return i, nil
+}
 
-		c := &Course{
-			ID:          uuid.New(),
-			CreatedAt:   time.Now(),
-			UpdatedAt:   time.Now(),
-			Name:        faker.Name(),
-			Slug:        faker.Username(),
-			Description: faker.Paragraph(),
-			PublishedAt: sql.NullTime{
-				Time:  start,
-				Valid: true,
-			},
-			Status:  CourseStatusPublished,
-			Batches: batches,
+// DeleteInstructor soft deletes the instructor. The instructor is no longer
+// listed in the courses and batches it is assigned to.
+func (s Service) DeleteInstructor(ctx context.Context, req *v1.DeleteInstructorRequest) error {
+	i, err := s.GetInstructor(ctx, &v1.GetInstructorRequest{Instructor: req.GetInstructor()})
+	if err != nil {
+		return err
+	}
+	i.UpdatedAt = time.Now()
+	i.DeletedAt = sql.NullTime{Time: i.UpdatedAt, Valid: true}
+	return s.store.UpdateInstructor(ctx, i)
+}
+
+func (s Service) AssignInstructor(ctx context.Context, req *v1.AssignInstructorRequest) (*Instructor, error) {
+	instructorID, courseID, batchID, err := parseInstructorAssignment(req.GetInstructor(), req.GetCourse(), req.GetBatch())
+	if err != nil {
+		return nil, err
+	}
+
+	var roles []string
+	for _, r := range req.GetRoles() {
+		if r = strings.TrimSpace(r); r != "" {
+			roles = append(roles, r)
 		}
+	}
 
-		err := s.store.CreateCourse(ctx, c)
+	if batchID.Valid {
+		err = s.store.AssignBatchInstructor(ctx, courseID, batchID.UUID, instructorID, roles)
+	} else {
+		err = s.store.AssignCourseInstructor(ctx, courseID, instructorID, roles)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	i, err := s.store.FindInstructorByID(ctx, instructorID.String())
+	if err != nil {
+		return nil, err
+	}
+	i.Roles = roles
+	return i, nil
+}
+
+func (s Service) UnassignInstructor(ctx context.Context, req *v1.UnassignInstructorRequest) error {
+	instructorID, courseID, batchID, err := parseInstructorAssignment(req.GetInstructor(), req.GetCourse(), req.GetBatch())
+	if err != nil {
+		return err
+	}
+	if batchID.Valid {
+		return s.store.UnassignBatchInstructor(ctx, courseID, batchID.UUID, instructorID)
+	}
+	return s.store.UnassignCourseInstructor(ctx, courseID, instructorID)
+}
+
+func parseInstructorAssignment(instructor, course, batch string) (instructorID, courseID uuid.UUID, batchID uuid.NullUUID, err error) {
+	if instructorID, err = uuid.Parse(instructor); err != nil {
+		return instructorID, courseID, batchID, ErrInvalidArgument{Message: "instructor must be a valid instructor id"}
+	}
+	if courseID, err = uuid.Parse(course); err != nil {
+		return instructorID, courseID, batchID, ErrInvalidArgument{Message: "course must be a valid course id"}
+	}
+	if batch != "" {
+		id, err := uuid.Parse(batch)
 		if err != nil {
-			return err
+			return instructorID, courseID, batchID, ErrInvalidArgument{Message: "batch must be a valid batch id"}
 		}
+		batchID = uuid.NullUUID{UUID: id, Valid: true}
 	}
-	return nil
+	return instructorID, courseID, batchID, nil
 }
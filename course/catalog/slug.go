@@ -0,0 +1,167 @@
+package catalog
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/imrenagicom/demo-app/internal/db"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+var nonSlugChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// slugify turns name into a lower case, dash separated slug.
+func slugify(name string) string {
+	return strings.Trim(nonSlugChars.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
+
+// resourceID strips the collection prefix of a resource name, so that both
+// "courses/golang-101" and "golang-101" refer to the same course.
+func resourceID(name, collection string) string {
+	if i := strings.LastIndex(name, collection+"/"); i >= 0 {
+		return name[i+len(collection)+1:]
+	}
+	return name
+}
+
+func (b Batch) resourceName() string {
+	if b.Slug != "" {
+		return b.Slug
+	}
+	return b.ID.String()
+}
+
+// ensureBatchSlugs generates slugs for batches without one. Slugs are unique
+// within the course.
+func (c *Course) ensureBatchSlugs() {
+	used := make(map[string]bool)
+	for _, b := range c.Batches {
+		if b.Slug != "" {
+			used[b.Slug] = true
+		}
+	}
+	for i := range c.Batches {
+		b := &c.Batches[i]
+		if b.Slug != "" {
+			continue
+		}
+		slug := slugify(b.Name)
+		if slug == "" {
+			slug = "batch"
+		}
+		if used[slug] {
+			slug = fmt.Sprintf("%s-%s", slug, b.ID.String()[:8])
+		}
+		used[slug] = true
+		b.Slug = slug
+	}
+}
+
+// FindCourse returns the published course by its slug or id. A previous slug
+// of the course resolves to the course as well. Use Course.Slug to tell
+// whether the given name is outdated.
+func (s *Store) FindCourse(ctx context.Context, name string) (*Course, error) {
+	id, err := s.resolveCourseID(ctx, resourceID(name, "courses"))
+	if err != nil {
+		return nil, err
+	}
+	return s.FindCourseByID(ctx, id.String())
+}
+
+// FindCourseBatch returns the published batch of the published course, both
+// given by slug or id. Previous slugs resolve as well.
+func (s *Store) FindCourseBatch(ctx context.Context, courseName, batchName string) (*Batch, error) {
+	c, err := s.FindCourse(ctx, courseName)
+	if err != nil {
+		return nil, err
+	}
+	return s.FindBatchOfCourse(ctx, c, batchName)
+}
+
+// FindBatchOfCourse returns the published batch of an already loaded course,
+// given by slug or id. Previous slugs resolve as well.
+func (s *Store) FindBatchOfCourse(ctx context.Context, c *Course, batchName string) (*Batch, error) {
+	batchID, err := s.resolveBatchID(ctx, c.ID, resourceID(batchName, "batches"))
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range c.Batches {
+		if b.ID == batchID {
+			return &b, nil
+		}
+	}
+	return nil, db.ErrResourceNotFound{Message: fmt.Sprintf("batch %s of course %s not found", batchName, c.Slug)}
+}
+
+func (s *Store) resolveCourseID(ctx context.Context, ref string) (uuid.UUID, error) {
+	if id, err := uuid.Parse(ref); err == nil {
+		return id, nil
+	}
+
+	sb := sq.StatementBuilder.RunWith(s.reader(ctx))
+	current := sb.Select("id").
+		From("courses").
+		Where(sq.Eq{"slug": ref, "deleted_at": nil}).
+		PlaceholderFormat(sq.Dollar)
+	previous := sb.Select("course_id").
+		From("course_slug_history").
+		Where(sq.Eq{"slug": ref}).
+		PlaceholderFormat(sq.Dollar)
+	return resolveSlug(ctx, fmt.Sprintf("course with slug %s not found", ref), current, previous)
+}
+
+func (s *Store) resolveBatchID(ctx context.Context, courseID uuid.UUID, ref string) (uuid.UUID, error) {
+	if id, err := uuid.Parse(ref); err == nil {
+		return id, nil
+	}
+
+	sb := sq.StatementBuilder.RunWith(s.reader(ctx))
+	current := sb.Select("id").
+		From("course_batches").
+		Where(sq.Eq{"course_id": courseID, "slug": ref, "deleted_at": nil}).
+		PlaceholderFormat(sq.Dollar)
+	previous := sb.Select("batch_id").
+		From("batch_slug_history").
+		Where(sq.Eq{"course_id": courseID, "slug": ref}).
+		PlaceholderFormat(sq.Dollar)
+	return resolveSlug(ctx, fmt.Sprintf("batch with slug %s not found", ref), current, previous)
+}
+
+// resolveSlug returns the id selected by the current slug query, falling back
+// to the slug history query.
+func resolveSlug(ctx context.Context, notFoundMsg string, queries ...sq.SelectBuilder) (uuid.UUID, error) {
+	for _, q := range queries {
+		var id uuid.UUID
+		err := q.QueryRowContext(ctx).Scan(&id)
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		return id, err
+	}
+	return uuid.Nil, db.ErrResourceNotFound{Message: notFoundMsg}
+}
+
+// Renamed reports whether name refers to the course by one of its previous slugs.
+func (c Course) Renamed(name string) bool {
+	return outdatedSlug(resourceID(name, "courses"), c.Slug)
+}
+
+// Renamed reports whether the batch is referred to by a previous slug of
+// either the course or the batch itself.
+func (b Batch) Renamed(courseName, batchName string) bool {
+	return outdatedSlug(resourceID(courseName, "courses"), b.CourseSlug) ||
+		outdatedSlug(resourceID(batchName, "batches"), b.Slug)
+}
+
+func outdatedSlug(ref, slug string) bool {
+	if _, err := uuid.Parse(ref); err == nil {
+		return false
+	}
+	return ref != slug
+}
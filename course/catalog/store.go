@@ -2,30 +2,57 @@ package catalog
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync/atomic"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/imrenagicom/demo-app/internal/chaos"
 	"github.com/imrenagicom/demo-app/internal/db"
+	"github.com/imrenagicom/demo-app/internal/pagination"
+	"github.com/imrenagicom/demo-app/internal/postgres"
 	"github.com/jmoiron/sqlx"
 	"github.com/redis/go-redis/v9"
 )
 
 var (
-	courseBatchKeyFmt = "course_batch:%s"
+	courseBatchKeyFmt      = "course_batch:%s"
+	courseBatchSeatsKeyFmt = "course_batch_seats:%s"
 )
 
-func NewStore(db *sqlx.DB, redis redis.UniversalClient) *Store {
-	return &Store{
+func NewStore(db *sqlx.DB, redis redis.UniversalClient, opts ...StoreOption) *Store {
+	s := &Store{
 		db:      db,
 		dbCache: sq.NewStmtCache(db),
 		redis:   redis,
 	}
+	for _, o := range opts {
+		o(s)
+	}
+	return s
 }
 
 type Store struct {
-	db      *sqlx.DB
-	dbCache *sq.StmtCache
-	redis   redis.UniversalClient
+	db       *sqlx.DB
+	dbCache  *sq.StmtCache
+	redis    redis.UniversalClient
+	replicas *postgres.Router
+	chaos    *chaos.Injector
+	// cacheDisabled turns the redis seat cache off, see SetCacheEnabled.
+	cacheDisabled atomic.Bool
+}
+
+// reader returns the runner of reads outside of transactions: a healthy
+// replica when the store has some and ctx is not pinned to the primary,
+// otherwise the primary.
+func (s *Store) reader(ctx context.Context) sq.BaseRunner {
+	if r, ok := s.replicas.Replica(ctx); ok {
+		return r
+	}
+	return s.dbCache
 }
 
 func (s *Store) Clear() error {
@@ -40,62 +67,108 @@ func (s *Store) FindAllCourse(ctx context.Context, opts ...ListOption) ([]Course
 		o(options)
 	}
 
-	nextPage := pageToken{page: options.Page + 1}.encode()
-	var courses []Course
+	if options.Order.Field == "" {
+		options.Order = DefaultCourseOrder
+	}
+	filter := pagination.Fingerprint("courses", options.InstructorID, options.CategoryRef, options.TagSlug)
+	cursor, err := pagination.Decode(options.PageToken, options.Order, filter)
+	if err != nil {
+		return nil, "", err
+	}
 
-	sb := sq.StatementBuilder.RunWith(s.dbCache)
+	var courses []Course
+	sortKey := courseSortKey(options.Order)
+	sb := sq.StatementBuilder.RunWith(s.reader(ctx))
 	selectCourses := sb.
-		Select("c.id", "c.name", "c.slug", "c.description", "c.status", "c.published_at").
+		Select("c.id", "c.name", "c.slug", "c.description", "c.status", "c.published_at", "c.created_at", "c.rating_average", "c.review_count", "c.unpublish_at").
 		From("courses c").
 		Where(sq.Eq{"c.deleted_at": nil, "c.status": CourseStatusPublished}).
-		OrderBy("c.published_at DESC").
-		Offset(uint64(options.GetOffset())).
-		Limit(uint64(options.Limit)).
+		OrderBy(options.Order.OrderBy(sortKey, "c.id")...).
+		Limit(options.Limit + 1).
 		PlaceholderFormat(sq.Dollar)
+	if cursor != nil {
+		selectCourses = selectCourses.Where(options.Order.After(sortKey, "c.id", cursor.Time, cursor.ID))
+	}
+	if options.InstructorID != "" {
+		selectCourses = selectCourses.Where(sq.Or{
+			sq.Expr("EXISTS (SELECT 1 FROM course_instructors ci WHERE ci.course_id = c.id AND ci.instructor_id = ?)", options.InstructorID),
+			sq.Expr("EXISTS (SELECT 1 FROM batch_instructors bi JOIN course_batches cb ON cb.id = bi.batch_id "+
+				"WHERE cb.course_id = c.id AND cb.deleted_at IS NULL AND bi.instructor_id = ?)", options.InstructorID),
+		})
+	}
+	if options.CategoryRef != "" {
+		selectCourses = selectCourses.Where(inCategory, options.CategoryRef, options.CategoryRef)
+	}
+	if options.TagSlug != "" {
+		selectCourses = selectCourses.Where(hasTag, options.TagSlug)
+	}
 
 	rows, err := selectCourses.QueryContext(ctx)
 	if err != nil {
 		return nil, "", err
 	}
+	defer rows.Close()
 
 	for rows.Next() {
 		var c Course
-		if err := rows.Scan(&c.ID, &c.Name, &c.Slug, &c.Description, &c.Status, &c.PublishedAt); err != nil {
+		if err := rows.Scan(&c.ID, &c.Name, &c.Slug, &c.Description, &c.Status, &c.PublishedAt, &c.CreatedAt, &c.RatingAverage, &c.ReviewCount, &c.UnpublishAt); err != nil {
 			return nil, "", err
 		}
+		courses = append(courses, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
 
-		if options.Preload {
-			batches, _, err := s.FindAllBatchesByCourseID(ctx, c.ID.String())
-			if err != nil {
-				return nil, "", err
-			}
-			c.Batches = batches
+	var nextPage string
+	if uint64(len(courses)) > options.Limit {
+		courses = courses[:options.Limit]
+		last := courses[len(courses)-1]
+		nextPage = pagination.Encode(pagination.Cursor{
+			Order:  options.Order.String(),
+			Filter: filter,
+			Time:   last.sortTime(options.Order),
+			ID:     last.ID,
+		})
+	}
+
+	if options.Preload {
+		if err := s.loadBatches(ctx, courses, options.BatchFields, options.BatchLimit); err != nil {
+			return nil, "", err
 		}
-		courses = append(courses, c)
+	}
+	if err := s.loadInstructors(ctx, courses); err != nil {
+		return nil, "", err
+	}
+	if err := s.loadPrices(ctx, courses); err != nil {
+		return nil, "", err
+	}
+	if err := s.loadTaxonomy(ctx, courses); err != nil {
+		return nil, "", err
 	}
 	return courses, nextPage, nil
 }
 
 func (s *Store) FindCourseByID(ctx context.Context, id string) (*Course, error) {
 	c := Course{}
-	sb := sq.StatementBuilder.RunWith(s.dbCache)
+	sb := sq.StatementBuilder.RunWith(s.reader(ctx))
 	getConcert := sb.
-		Select("c.id", "c.name", "c.slug", "c.description", "c.status", "c.published_at").
+		Select("c.id", "c.name", "c.slug", "c.description", "c.status", "c.published_at", "c.rating_average", "c.review_count", "c.unpublish_at").
 		From("courses c").
 		Where(sq.Eq{"c.deleted_at": nil, "c.id": id, "c.status": CourseStatusPublished}).
 		PlaceholderFormat(sq.Dollar)
 	if err := getConcert.QueryRowContext(ctx).Scan(
-		&c.ID, &c.Name, &c.Slug, &c.Description, &c.Status, &c.PublishedAt,
+		&c.ID, &c.Name, &c.Slug, &c.Description, &c.Status, &c.PublishedAt, &c.RatingAverage, &c.ReviewCount, &c.UnpublishAt,
 	); err != nil {
-		// if errors.Is(err, sql.ErrNoRows) {
-		// 	return nil, db.ErrResourceNotFound{Message: fmt.Sprintf("course with id %s not found", id)}
-		// }
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, db.ErrResourceNotFound{Message: fmt.Sprintf("course with id %s not found", id)}
+		}
 		return nil, err
 	}
 
 	var batches []Batch
 	selectBatches := sb.
-		Select("id", "name", "max_seats", "available_seats", "price", "currency", "start_date", "end_date", "version").
+		Select("id", "name", "slug", "max_seats", "available_seats", "price", "currency", "start_date", "end_date", "version", "status", "sales_open_at", "sales_close_at", "unpublish_at").
 		From("course_batches").
 		Where(sq.Eq{"course_id": c.ID, "deleted_at": nil, "status": BatchStatusPublished}).
 		PlaceholderFormat(sq.Dollar)
@@ -106,14 +179,27 @@ func (s *Store) FindCourseByID(ctx context.Context, id string) (*Course, error)
 	for rows.Next() {
 		var b Batch
 		if err := rows.Scan(
-			&b.ID, &b.Name, &b.MaxSeats, &b.AvailableSeats, &b.Price, &b.Currency, &b.StartDate, &b.EndDate, &b.Version,
+			&b.ID, &b.Name, &b.Slug, &b.MaxSeats, &b.AvailableSeats, &b.Price, &b.Currency, &b.StartDate, &b.EndDate, &b.Version, &b.Status,
+			&b.SalesOpenAt, &b.SalesCloseAt, &b.UnpublishAt,
 		); err != nil {
 			return nil, err
 		}
+		b.CourseSlug = c.Slug
 		batches = append(batches, b)
 	}
 	c.Batches = batches
-	return &c, nil
+
+	courses := []Course{c}
+	if err := s.loadInstructors(ctx, courses); err != nil {
+		return nil, err
+	}
+	if err := s.loadPrices(ctx, courses); err != nil {
+		return nil, err
+	}
+	if err := s.loadTaxonomy(ctx, courses); err != nil {
+		return nil, err
+	}
+	return &courses[0], nil
 }
 
 func (c *Store) CreateCourse(ctx context.Context, course *Course) error {
@@ -131,10 +217,11 @@ func (c *Store) CreateCourse(ctx context.Context, course *Course) error {
 
 	insertBatches := sb.
 		Insert("course_batches").
-		Columns("id", "name", "max_seats", "available_seats", "price", "currency", "start_date", "end_date", "course_id", "created_at", "updated_at", "status").
+		Columns("id", "name", "slug", "max_seats", "available_seats", "price", "currency", "start_date", "end_date", "course_id", "created_at", "updated_at", "status").
 		PlaceholderFormat(sq.Dollar)
+	course.ensureBatchSlugs()
 	for _, b := range course.Batches {
-		insertBatches = insertBatches.Values(b.ID, b.Name, b.MaxSeats, b.AvailableSeats, b.Price, b.Currency, b.StartDate, b.EndDate, course.ID, b.CreatedAt, b.UpdatedAt, b.Status)
+		insertBatches = insertBatches.Values(b.ID, b.Name, b.Slug, b.MaxSeats, b.AvailableSeats, b.Price, b.Currency, b.StartDate, b.EndDate, course.ID, b.CreatedAt, b.UpdatedAt, b.Status)
 	}
 
 	_, err = insertCourse.ExecContext(ctx)
@@ -170,13 +257,14 @@ func (c *Store) FindCourseBatchByID(ctx context.Context, id string, opts ...Find
 	}
 
 	selectBatch := sb.
-		Select("id", "name", "max_seats", "available_seats", "price", "currency", "start_date", "end_date", "version", "status").
+		Select("id", "name", "slug", "max_seats", "available_seats", "price", "currency", "start_date", "end_date", "version", "status", "sales_open_at", "sales_close_at", "unpublish_at").
 		From("course_batches").
 		Where(sq.Eq{"id": id, "deleted_at": nil}).
 		PlaceholderFormat(sq.Dollar)
 
 	err := selectBatch.QueryRowContext(ctx).
-		Scan(&b.ID, &b.Name, &b.MaxSeats, &b.AvailableSeats, &b.Price, &b.Currency, &b.StartDate, &b.EndDate, &b.Version, &b.Status)
+		Scan(&b.ID, &b.Name, &b.Slug, &b.MaxSeats, &b.AvailableSeats, &b.Price, &b.Currency, &b.StartDate, &b.EndDate, &b.Version, &b.Status,
+			&b.SalesOpenAt, &b.SalesCloseAt, &b.UnpublishAt)
 	if err != nil {
 		return nil, err
 	}
@@ -184,6 +272,9 @@ func (c *Store) FindCourseBatchByID(ctx context.Context, id string, opts ...Find
 }
 
 func (c *Store) FindCourseBatchByIDAndCourseID(ctx context.Context, batchID, courseID string, opts ...FindOption) (*Batch, error) {
+	if err := c.chaos.Inject(ctx, "catalog.Store.FindCourseBatchByIDAndCourseID"); err != nil {
+		return nil, err
+	}
 	options := &FindOptions{}
 	for _, o := range opts {
 		o(options)
@@ -197,14 +288,18 @@ func (c *Store) FindCourseBatchByIDAndCourseID(ctx context.Context, batchID, cou
 	}
 
 	selectBatch := sb.
-		Select("cb.id", "cb.name", "cb.max_seats", "cb.available_seats", "cb.price", "cb.currency", "cb.start_date", "cb.end_date", "cb.version", "cb.status").
+		Select("cb.id", "cb.name", "cb.slug", "cb.max_seats", "cb.available_seats", "cb.price", "cb.currency", "cb.start_date", "cb.end_date", "cb.version", "cb.status", "cb.sales_open_at", "cb.sales_close_at", "cb.unpublish_at").
 		From("course_batches cb").
 		Where(sq.Eq{"cb.id": batchID, "cb.course_id": courseID}).
 		PlaceholderFormat(sq.Dollar)
+	if options.Tx != nil && options.ForUpdate {
+		selectBatch = selectBatch.Suffix("FOR UPDATE")
+	}
 
 	var b Batch
 	err := selectBatch.QueryRowContext(ctx).
-		Scan(&b.ID, &b.Name, &b.MaxSeats, &b.AvailableSeats, &b.Price, &b.Currency, &b.StartDate, &b.EndDate, &b.Version, &b.Status)
+		Scan(&b.ID, &b.Name, &b.Slug, &b.MaxSeats, &b.AvailableSeats, &b.Price, &b.Currency, &b.StartDate, &b.EndDate, &b.Version, &b.Status,
+			&b.SalesOpenAt, &b.SalesCloseAt, &b.UnpublishAt)
 	if err != nil {
 		return nil, err
 	}
@@ -212,6 +307,9 @@ func (c *Store) FindCourseBatchByIDAndCourseID(ctx context.Context, batchID, cou
 }
 
 func (c *Store) UpdateBatchAvailableSeats(ctx context.Context, b *Batch, opts ...UpdateOption) error {
+	if err := c.chaos.Inject(ctx, "catalog.Store.UpdateBatchAvailableSeats"); err != nil {
+		return err
+	}
 	options := &UpdateOptions{}
 	for _, o := range opts {
 		o(options)
@@ -249,7 +347,216 @@ func (c *Store) UpdateBatchAvailableSeats(ctx context.Context, b *Batch, opts ..
 	return nil
 }
 
-func (c *Store) FindAllBatchesByCourseID(ctx context.Context, courseID string, opts ...ListOption) ([]Batch, string, error) {	
+// ReserveBatchSeats atomically takes n seats of a batch with limited seats. It
+// does not rely on the batch version, thus it never conflicts with concurrent
+// updates. The seats are only taken while the batch is published and its
+// sales are open, so that a batch unpublished or closed since it was read is
+// not sold. ErrNotEnoughSeats is returned when less than n seats are
+// available, ErrClassNotAvailableForSale when the batch is no longer for sale.
+func (c *Store) ReserveBatchSeats(ctx context.Context, batchID uuid.UUID, n int32, opts ...UpdateOption) error {
+	if err := c.chaos.Inject(ctx, "catalog.Store.ReserveBatchSeats"); err != nil {
+		return err
+	}
+	options := &UpdateOptions{}
+	for _, o := range opts {
+		o(options)
+	}
+
+	sb := sq.StatementBuilder
+	if options.Tx != nil {
+		sb = sb.RunWith(options.Tx)
+	} else {
+		sb = sb.RunWith(c.dbCache)
+	}
+
+	now := time.Now()
+	forSale := sq.And{
+		sq.Eq{"id": batchID, "deleted_at": nil, "status": BatchStatusPublished},
+		sq.Or{sq.Eq{"sales_open_at": nil}, sq.LtOrEq{"sales_open_at": now}},
+		sq.Or{sq.Eq{"sales_close_at": nil}, sq.Gt{"sales_close_at": now}},
+	}
+	reserveSeats := sb.
+		Update("course_batches").
+		Set("available_seats", sq.Expr("available_seats - ?", n)).
+		Set("version", sq.Expr("version + 1")).
+		Set("updated_at", now).
+		Where(forSale).
+		Where(sq.GtOrEq{"available_seats": n}).
+		PlaceholderFormat(sq.Dollar)
+
+	res, err := reserveSeats.ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		// tell the seats being gone from the batch being withdrawn from sale.
+		var one int
+		err := sb.Select("1").
+			From("course_batches").
+			Where(forSale).
+			PlaceholderFormat(sq.Dollar).
+			QueryRowContext(ctx).
+			Scan(&one)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrClassNotAvailableForSale
+		}
+		if err != nil {
+			return err
+		}
+		return ErrNotEnoughSeats
+	}
+	return nil
+}
+
+// ReleaseBatchSeats atomically gives n seats back to a batch with limited seats.
+func (c *Store) ReleaseBatchSeats(ctx context.Context, batchID uuid.UUID, n int32, opts ...UpdateOption) error {
+	if err := c.chaos.Inject(ctx, "catalog.Store.ReleaseBatchSeats"); err != nil {
+		return err
+	}
+	options := &UpdateOptions{}
+	for _, o := range opts {
+		o(options)
+	}
+
+	sb := sq.StatementBuilder
+	if options.Tx != nil {
+		sb = sb.RunWith(options.Tx)
+	} else {
+		sb = sb.RunWith(c.dbCache)
+	}
+
+	releaseSeats := sb.
+		Update("course_batches").
+		Set("available_seats", sq.Expr("available_seats + ?", n)).
+		Set("version", sq.Expr("version + 1")).
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{"id": batchID}).
+		Where(sq.Gt{"max_seats": 0}).
+		PlaceholderFormat(sq.Dollar)
+
+	_, err := releaseSeats.ExecContext(ctx)
+	return err
+}
+
+// reserveSeatsScript decrements the seat counter of a batch when there are
+// enough seats. The counter is initialized from ARGV[2], the number of available
+// seats read from postgres, and expires after ARGV[3] seconds so that it is
+// periodically synchronized with postgres again.
+var reserveSeatsScript = redis.NewScript(`
+local seats = redis.call('GET', KEYS[1])
+if not seats then
+	redis.call('SET', KEYS[1], ARGV[2], 'EX', ARGV[3], 'NX')
+	seats = redis.call('GET', KEYS[1])
+end
+if tonumber(seats) < tonumber(ARGV[1]) then
+	return -1
+end
+return redis.call('DECRBY', KEYS[1], ARGV[1])
+`)
+
+// releaseSeatsScript increments the seat counter of a batch if the counter exists.
+var releaseSeatsScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	return redis.call('INCRBY', KEYS[1], ARGV[1])
+end
+return -1
+`)
+
+// seatCounterTTL is how long the redis seat counter is trusted before it is
+// initialized again from postgres, unless it is corrected by SetSeatsInCache.
+var seatCounterTTL = 30 * time.Second
+
+// ErrCacheUnavailable is returned when redis can not be reached, such as
+// while its circuit breaker is open.
+var ErrCacheUnavailable = errors.New("seat cache is unavailable")
+
+// ReserveSeatsInCache takes n seats from the redis seat counter of the batch.
+// When the counter does not exist yet, it is initialized with available.
+// ErrNotEnoughSeats is returned when the counter has less than n seats left,
+// and ErrCacheUnavailable when redis can not be reached.
+func (c *Store) ReserveSeatsInCache(ctx context.Context, batchID uuid.UUID, n, available int32) error {
+	if !c.CacheEnabled() {
+		return fmt.Errorf("%w: disabled", ErrCacheUnavailable)
+	}
+	var left int
+	// injected faults stand for redis failures.
+	err := c.chaos.Inject(ctx, "catalog.Store.ReserveSeatsInCache")
+	if err == nil {
+		key := fmt.Sprintf(courseBatchSeatsKeyFmt, batchID)
+		left, err = reserveSeatsScript.Run(ctx, c.redis, []string{key}, n, available, int(seatCounterTTL.Seconds())).Int()
+	}
+	var reply redis.Error
+	if err != nil && !errors.As(err, &reply) {
+		return fmt.Errorf("%w: %v", ErrCacheUnavailable, err)
+	}
+	if err != nil {
+		return err
+	}
+	if left < 0 {
+		return ErrNotEnoughSeats
+	}
+	return nil
+}
+
+// ReleaseSeatsInCache gives n seats back to the redis seat counter of the batch.
+// A negative n takes the seats instead. Nothing is changed when the counter
+// does not exist or the cache is disabled.
+func (c *Store) ReleaseSeatsInCache(ctx context.Context, batchID uuid.UUID, n int32) error {
+	if !c.CacheEnabled() {
+		return nil
+	}
+	key := fmt.Sprintf(courseBatchSeatsKeyFmt, batchID)
+	return releaseSeatsScript.Run(ctx, c.redis, []string{key}, n).Err()
+}
+
+// SetSeatsInCache corrects the redis seat counter of the batch to available,
+// the available seats read from postgres, and restarts its expiry. A counter
+// which does not exist is left to be initialized by the next reservation.
+// Nothing is changed when the cache is disabled.
+func (c *Store) SetSeatsInCache(ctx context.Context, batchID uuid.UUID, available int32) error {
+	if !c.CacheEnabled() {
+		return nil
+	}
+	key := fmt.Sprintf(courseBatchSeatsKeyFmt, batchID)
+	return c.redis.SetXX(ctx, key, available, seatCounterTTL).Err()
+}
+
+// SetCacheEnabled turns the redis seat cache on or off. While it is off,
+// ReserveSeatsInCache returns ErrCacheUnavailable, so that reservations only
+// use postgres. Counters may be stale when it is turned on again until they
+// expire or are flushed.
+func (c *Store) SetCacheEnabled(enabled bool) {
+	c.cacheDisabled.Store(!enabled)
+}
+
+// CacheEnabled reports whether the redis seat cache is used.
+func (c *Store) CacheEnabled() bool {
+	return !c.cacheDisabled.Load()
+}
+
+// FlushCache deletes the redis seat counters of all batches and returns the
+// number of deleted counters. They are initialized again from postgres on the
+// next reservation.
+func (c *Store) FlushCache(ctx context.Context) (int, error) {
+	pattern := fmt.Sprintf(courseBatchSeatsKeyFmt, "*")
+	deleted := 0
+	iter := c.redis.Scan(ctx, 0, pattern, 100).Iterator()
+	for iter.Next(ctx) {
+		if err := c.redis.Del(ctx, iter.Val()).Err(); err != nil {
+			return deleted, err
+		}
+		deleted++
+	}
+	return deleted, iter.Err()
+}
+
+func (c *Store) FindAllBatchesByCourseID(ctx context.Context, courseID string, opts ...ListOption) ([]Batch, string, error) {
 	options := &ListOptions{
 		Limit: 10,
 	}
@@ -257,31 +564,65 @@ func (c *Store) FindAllBatchesByCourseID(ctx context.Context, courseID string, o
 		o(options)
 	}
 
-	nextPage := pageToken{page: options.Page + 1}.encode()
+	filter := batchesFilter(courseID)
+	cursor, err := pagination.Decode(options.PageToken, batchOrder, filter)
+	if err != nil {
+		return nil, "", err
+	}
+
 	var batches []Batch
 	sb := sq.StatementBuilder.RunWith(c.dbCache)
 	selectBatches := sb.
-		Select("id", "name", "max_seats", "available_seats", "price", "currency", "start_date", "end_date", "version").
+		Select("id", "name", "slug", "max_seats", "available_seats", "price", "currency", "start_date", "end_date", "version", "created_at", "sales_open_at", "sales_close_at", "unpublish_at").
 		From("course_batches").
 		Where(sq.Eq{"course_id": courseID, "deleted_at": nil, "status": BatchStatusPublished}).
-		OrderBy("created_at DESC").
-		Offset(uint64(options.GetOffset())).
-		Limit(uint64(options.Limit)).
+		OrderBy(batchOrder.OrderBy("created_at", "id")...).
+		Limit(options.Limit + 1).
 		PlaceholderFormat(sq.Dollar)
+	if cursor != nil {
+		selectBatches = selectBatches.Where(batchOrder.After("created_at", "id", cursor.Time, cursor.ID))
+	}
 
 	rows, err := selectBatches.QueryContext(ctx)
 	if err != nil {
 		return nil, "", err
 	}
+	defer rows.Close()
 
 	for rows.Next() {
 		var b Batch
 		if err := rows.Scan(
-			&b.ID, &b.Name, &b.MaxSeats, &b.AvailableSeats, &b.Price, &b.Currency, &b.StartDate, &b.EndDate, &b.Version,
+			&b.ID, &b.Name, &b.Slug, &b.MaxSeats, &b.AvailableSeats, &b.Price, &b.Currency, &b.StartDate, &b.EndDate, &b.Version, &b.CreatedAt,
+			&b.SalesOpenAt, &b.SalesCloseAt, &b.UnpublishAt,
 		); err != nil {
 			return nil, "", err
 		}
 		batches = append(batches, b)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	var nextPage string
+	if uint64(len(batches)) > options.Limit {
+		batches = batches[:options.Limit]
+		nextPage = batchesNextPage(courseID, batches[len(batches)-1])
+	}
 	return batches, nextPage, nil
 }
+
+var batchOrder = pagination.Order{Field: "created_at", Desc: true}
+
+func batchesFilter(courseID string) string {
+	return pagination.Fingerprint("batches", courseID)
+}
+
+// batchesNextPage returns the token listing the batches of the course following last.
+func batchesNextPage(courseID string, last Batch) string {
+	return pagination.Encode(pagination.Cursor{
+		Order:  batchOrder.String(),
+		Filter: batchesFilter(courseID),
+		Time:   last.CreatedAt,
+		ID:     last.ID,
+	})
+}
@@ -0,0 +1,378 @@
+package catalog
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/imrenagicom/demo-app/internal/db"
+	v1 "github.com/imrenagicom/demo-app/pkg/apiclient/course/v1"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+func NewCategory(name, description string, parent *Category) *Category {
+	c := &Category{
+		ID:          uuid.New(),
+		Name:        strings.TrimSpace(name),
+		Slug:        slugify(name),
+		Description: strings.TrimSpace(description),
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+	if parent != nil {
+		c.ParentID = uuid.NullUUID{UUID: parent.ID, Valid: true}
+		c.Path = append(append([]string{}, parent.Path...), parent.Slug)
+	}
+	return c
+}
+
+type Category struct {
+	ID          uuid.UUID
+	ParentID    uuid.NullUUID
+	Name        string
+	Slug        string
+	Description string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+
+	// Path are the slugs of the ancestors, starting from the top level category.
+	Path []string
+	// CourseCount is the number of published courses in the category and its descendants.
+	CourseCount int64
+}
+
+func (c *Category) Validate() error {
+	if c.Name == "" {
+		return ErrInvalidArgument{Message: "category display_name is required"}
+	}
+	if c.Slug == "" || slugify(c.Slug) != c.Slug {
+		return ErrInvalidArgument{Message: "category name must be a slug of lower case letters, digits and dashes"}
+	}
+	return nil
+}
+
+func (c Category) ApiV1() *v1.Category {
+	var parent string
+	if len(c.Path) > 0 {
+		parent = c.Path[len(c.Path)-1]
+	}
+	return &v1.Category{
+		Name:        c.Slug,
+		CategoryId:  c.ID.String(),
+		DisplayName: c.Name,
+		Description: c.Description,
+		Parent:      parent,
+		Path:        c.Path,
+		CourseCount: c.CourseCount,
+	}
+}
+
+type Tag struct {
+	ID          uuid.UUID
+	Name        string
+	Slug        string
+	CourseCount int64
+}
+
+func (t Tag) ApiV1() *v1.Tag {
+	return &v1.Tag{
+		Name:        t.Slug,
+		DisplayName: t.Name,
+		CourseCount: t.CourseCount,
+	}
+}
+
+// categoryTree selects all categories with the slugs of their ancestors.
+const categoryTree = `WITH RECURSIVE tree AS (
+	SELECT c.id, c.parent_id, c.name, c.slug, c.description, c.created_at, c.updated_at, ARRAY[]::varchar[] AS path
+	FROM categories c
+	WHERE c.parent_id IS NULL AND c.deleted_at IS NULL
+	UNION ALL
+	SELECT c.id, c.parent_id, c.name, c.slug, c.description, c.created_at, c.updated_at, t.path || t.slug
+	FROM categories c
+	JOIN tree t ON c.parent_id = t.id
+	WHERE c.deleted_at IS NULL
+)`
+
+// categoryCourseCount counts the published courses in category t or one of its descendants.
+const categoryCourseCount = `(SELECT COUNT(DISTINCT cc.course_id)
+	FROM course_categories cc
+	JOIN courses co ON co.id = cc.course_id
+	JOIN tree d ON d.id = cc.category_id
+	WHERE (d.id = t.id OR t.slug = ANY(d.path)) AND co.deleted_at IS NULL AND co.status = ?)`
+
+// inCategory selects the courses c in the category, given by slug or id, or one of its descendants.
+const inCategory = `EXISTS (SELECT 1 FROM course_categories cc WHERE cc.course_id = c.id AND cc.category_id IN (
+	WITH RECURSIVE sub AS (
+		SELECT id FROM categories WHERE (slug = ? OR id::text = ?) AND deleted_at IS NULL
+		UNION ALL
+		SELECT ch.id FROM categories ch JOIN sub ON ch.parent_id = sub.id WHERE ch.deleted_at IS NULL
+	)
+	SELECT id FROM sub))`
+
+// hasTag selects the courses c having the tag, given by slug.
+const hasTag = `EXISTS (SELECT 1 FROM course_tags ct JOIN tags tg ON tg.id = ct.tag_id WHERE ct.course_id = c.id AND tg.slug = ?)`
+
+func (s *Store) CreateCategory(ctx context.Context, c *Category) error {
+	sb := sq.StatementBuilder.RunWith(s.dbCache)
+	insertCategory := sb.Insert("categories").
+		Columns("id", "parent_id", "name", "slug", "description", "created_at", "updated_at").
+		Values(c.ID, c.ParentID, c.Name, c.Slug, c.Description, c.CreatedAt, c.UpdatedAt).
+		PlaceholderFormat(sq.Dollar)
+	_, err := insertCategory.ExecContext(ctx)
+	if db.IsUniqueViolation(err) {
+		return ErrAlreadyExists{Message: fmt.Sprintf("category %s already exists", c.Slug)}
+	}
+	return err
+}
+
+// EnsureCategory creates the category unless a category with the same slug
+// exists. The id of c is set to the id of the stored category.
+func (s *Store) EnsureCategory(ctx context.Context, c *Category) error {
+	sb := sq.StatementBuilder.RunWith(s.dbCache)
+	upsertCategory := sb.Insert("categories").
+		Columns("id", "parent_id", "name", "slug", "description", "created_at", "updated_at").
+		Values(c.ID, c.ParentID, c.Name, c.Slug, c.Description, c.CreatedAt, c.UpdatedAt).
+		Suffix("ON CONFLICT (slug) DO UPDATE SET slug = EXCLUDED.slug RETURNING id").
+		PlaceholderFormat(sq.Dollar)
+	return upsertCategory.QueryRowContext(ctx).Scan(&c.ID)
+}
+
+// FindCategory returns the category by its slug or id.
+func (s *Store) FindCategory(ctx context.Context, ref string) (*Category, error) {
+	categories, err := s.findCategories(ctx, sq.Or{sq.Eq{"t.slug": ref}, sq.Expr("t.id::text = ?", ref)})
+	if err != nil {
+		return nil, err
+	}
+	if len(categories) == 0 {
+		return nil, db.ErrResourceNotFound{Message: fmt.Sprintf("category %s not found", ref)}
+	}
+	return &categories[0], nil
+}
+
+// FindAllCategories returns the children of the parent category, or the top
+// level categories when parent is nil. All descendants are returned when
+// recursive is set.
+func (s *Store) FindAllCategories(ctx context.Context, parent *Category, recursive bool) ([]Category, error) {
+	var filter sq.Sqlizer
+	switch {
+	case parent == nil && recursive:
+		filter = sq.Expr("TRUE")
+	case parent == nil:
+		filter = sq.Eq{"t.parent_id": nil}
+	case recursive:
+		filter = sq.Expr("? = ANY(t.path)", parent.Slug)
+	default:
+		filter = sq.Eq{"t.parent_id": parent.ID}
+	}
+	return s.findCategories(ctx, filter)
+}
+
+func (s *Store) findCategories(ctx context.Context, filter sq.Sqlizer) ([]Category, error) {
+	sb := sq.StatementBuilder.RunWith(s.reader(ctx))
+	query := sb.Select("t.id", "t.parent_id", "t.name", "t.slug", "COALESCE(t.description, '')", "t.created_at", "t.updated_at", "t.path").
+		Column(sq.Expr(categoryCourseCount, CourseStatusPublished)).
+		Prefix(categoryTree).
+		From("tree t").
+		Where(filter).
+		OrderBy("t.path || t.slug").
+		PlaceholderFormat(sq.Dollar)
+
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var categories []Category
+	for rows.Next() {
+		var c Category
+		var path pq.StringArray
+		if err := rows.Scan(&c.ID, &c.ParentID, &c.Name, &c.Slug, &c.Description, &c.CreatedAt, &c.UpdatedAt, &path, &c.CourseCount); err != nil {
+			return nil, err
+		}
+		c.Path = path
+		categories = append(categories, c)
+	}
+	return categories, rows.Err()
+}
+
+// FindAllTags returns up to limit tags having at least minCount published
+// courses, the most used first.
+func (s *Store) FindAllTags(ctx context.Context, minCount int64, limit uint64) ([]Tag, error) {
+	sb := sq.StatementBuilder.RunWith(s.reader(ctx))
+	query := sb.Select("t.id", "t.name", "t.slug", "COUNT(co.id)").
+		From("tags t").
+		LeftJoin("course_tags ct ON ct.tag_id = t.id").
+		LeftJoin("courses co ON co.id = ct.course_id AND co.deleted_at IS NULL AND co.status = ?", CourseStatusPublished).
+		GroupBy("t.id").
+		Having("COUNT(co.id) >= ?", minCount).
+		OrderBy("4 DESC", "t.slug").
+		Limit(limit).
+		PlaceholderFormat(sq.Dollar)
+
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tags []Tag
+	for rows.Next() {
+		var t Tag
+		if err := rows.Scan(&t.ID, &t.Name, &t.Slug, &t.CourseCount); err != nil {
+			return nil, err
+		}
+		tags = append(tags, t)
+	}
+	return tags, rows.Err()
+}
+
+// SetCourseCategories replaces the categories of the course.
+func (s *Store) SetCourseCategories(ctx context.Context, courseID uuid.UUID, categoryIDs []uuid.UUID) error {
+	return s.replaceCourseLinks(ctx, "course_categories", "category_id", courseID, categoryIDs)
+}
+
+// SetCourseTags replaces the tags of the course. Tags are identified by the
+// slug of their names and are created when they do not exist yet.
+func (s *Store) SetCourseTags(ctx context.Context, courseID uuid.UUID, names []string) ([]Tag, error) {
+	var tags []Tag
+	seen := make(map[string]bool)
+	sb := sq.StatementBuilder.RunWith(s.dbCache)
+	for _, name := range names {
+		t := Tag{ID: uuid.New(), Name: strings.TrimSpace(name), Slug: slugify(name)}
+		if t.Slug == "" {
+			return nil, ErrInvalidArgument{Message: fmt.Sprintf("invalid tag %q", name)}
+		}
+		if seen[t.Slug] {
+			continue
+		}
+		seen[t.Slug] = true
+
+		// the no-op update makes RETURNING return the id of an existing tag
+		upsertTag := sb.Insert("tags").
+			Columns("id", "name", "slug").
+			Values(t.ID, t.Name, t.Slug).
+			Suffix("ON CONFLICT (slug) DO UPDATE SET slug = EXCLUDED.slug RETURNING id, name").
+			PlaceholderFormat(sq.Dollar)
+		if err := upsertTag.QueryRowContext(ctx).Scan(&t.ID, &t.Name); err != nil {
+			return nil, err
+		}
+		tags = append(tags, t)
+	}
+
+	var ids []uuid.UUID
+	for _, t := range tags {
+		ids = append(ids, t.ID)
+	}
+	if err := s.replaceCourseLinks(ctx, "course_tags", "tag_id", courseID, ids); err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+func (s *Store) replaceCourseLinks(ctx context.Context, table, column string, courseID uuid.UUID, ids []uuid.UUID) error {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	sb := sq.StatementBuilder.RunWith(tx)
+	deleteLinks := sb.Delete(table).
+		Where(sq.Eq{"course_id": courseID}).
+		PlaceholderFormat(sq.Dollar)
+	if _, err := deleteLinks.ExecContext(ctx); err != nil {
+		return err
+	}
+
+	if len(ids) > 0 {
+		insertLinks := sb.Insert(table).
+			Columns("course_id", column).
+			Suffix("ON CONFLICT DO NOTHING").
+			PlaceholderFormat(sq.Dollar)
+		for _, id := range ids {
+			insertLinks = insertLinks.Values(courseID, id)
+		}
+		if _, err := insertLinks.ExecContext(ctx); err != nil {
+			if db.IsForeignKeyViolation(err) {
+				return db.ErrResourceNotFound{Message: fmt.Sprintf("course %s not found", courseID)}
+			}
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// loadTaxonomy populates the category and tag slugs of the courses.
+func (s *Store) loadTaxonomy(ctx context.Context, courses []Course) error {
+	if len(courses) == 0 {
+		return nil
+	}
+	var ids []string
+	for _, c := range courses {
+		ids = append(ids, c.ID.String())
+	}
+
+	categories, err := s.findCourseLinkSlugs(ctx, "course_categories l JOIN categories x ON x.id = l.category_id AND x.deleted_at IS NULL", ids)
+	if err != nil {
+		return err
+	}
+	tags, err := s.findCourseLinkSlugs(ctx, "course_tags l JOIN tags x ON x.id = l.tag_id", ids)
+	if err != nil {
+		return err
+	}
+	for i := range courses {
+		courses[i].Categories = categories[courses[i].ID]
+		courses[i].Tags = tags[courses[i].ID]
+	}
+	return nil
+}
+
+func (s *Store) findCourseLinkSlugs(ctx context.Context, from string, courseIDs []string) (map[uuid.UUID][]string, error) {
+	sb := sq.StatementBuilder.RunWith(s.reader(ctx))
+	query := sb.Select("l.course_id", "x.slug").
+		From(from).
+		Where(sq.Expr("l.course_id = ANY(?::uuid[])", pq.StringArray(courseIDs))).
+		OrderBy("x.slug").
+		PlaceholderFormat(sq.Dollar)
+
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make(map[uuid.UUID][]string)
+	for rows.Next() {
+		var id uuid.UUID
+		var slug string
+		if err := rows.Scan(&id, &slug); err != nil {
+			return nil, err
+		}
+		res[id] = append(res[id], slug)
+	}
+	return res, rows.Err()
+}
+
+// findCategoryID returns the id of the category given by slug or id.
+func (s *Store) findCategoryID(ctx context.Context, ref string) (uuid.UUID, error) {
+	sb := sq.StatementBuilder.RunWith(s.reader(ctx))
+	query := sb.Select("id").
+		From("categories").
+		Where(sq.Or{sq.Eq{"slug": ref}, sq.Expr("id::text = ?", ref)}).
+		Where(sq.Eq{"deleted_at": nil}).
+		PlaceholderFormat(sq.Dollar)
+	var id uuid.UUID
+	err := query.QueryRowContext(ctx).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, db.ErrResourceNotFound{Message: fmt.Sprintf("category %s not found", ref)}
+	}
+	return id, err
+}
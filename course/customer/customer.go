@@ -0,0 +1,208 @@
+package customer
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"net/mail"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	v1 "github.com/imrenagicom/demo-app/pkg/apiclient/course/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// e164Pattern matches phone number in E.164 format, e.g. +6281234567890.
+var e164Pattern = regexp.MustCompile(`^\+[1-9]\d{1,14}$`)
+
+type Address struct {
+	StreetAddress string `json:"street_address"`
+	AptSuite      string `json:"apt_suite"`
+	City          string `json:"city"`
+	Country       string `json:"country"`
+	ZipCode       string `json:"zip_code"`
+	State         string `json:"state"`
+}
+
+func AddressFromApiV1(a *v1.Address) *Address {
+	if a == nil {
+		return nil
+	}
+	return &Address{
+		StreetAddress: a.GetStreetAddress(),
+		AptSuite:      a.GetAptSuite(),
+		City:          a.GetCity(),
+		Country:       a.GetCountry(),
+		ZipCode:       a.GetZipCode(),
+		State:         a.GetState(),
+	}
+}
+
+func (a *Address) ApiV1() *v1.Address {
+	if a == nil {
+		return nil
+	}
+	return &v1.Address{
+		StreetAddress: a.StreetAddress,
+		AptSuite:      a.AptSuite,
+		City:          a.City,
+		Country:       a.Country,
+		ZipCode:       a.ZipCode,
+		State:         a.State,
+	}
+}
+
+// Value stores the address as a jsonb column.
+func (a *Address) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
+	return json.Marshal(a)
+}
+
+// Scan reads the address from a jsonb column.
+func (a *Address) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return json.Unmarshal(v, a)
+	case string:
+		return json.Unmarshal([]byte(v), a)
+	default:
+		return errors.New("unsupported address type")
+	}
+}
+
+// nullAddress is used for scanning nullable address column.
+type nullAddress struct {
+	Address *Address
+}
+
+func (n *nullAddress) Scan(src any) error {
+	if src == nil {
+		n.Address = nil
+		return nil
+	}
+	n.Address = &Address{}
+	return n.Address.Scan(src)
+}
+
+func New(name, email, phone string) *Customer {
+	return &Customer{
+		ID:        uuid.New(),
+		Name:      strings.TrimSpace(name),
+		Email:     normalizeEmail(email),
+		Phone:     normalizePhone(phone),
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
+
+func FromApiV1(c *v1.Customer) *Customer {
+	cust := New(c.GetName(), c.GetEmail(), c.GetPhoneNumber())
+	cust.ShippingAddress = AddressFromApiV1(c.GetShippingAddress())
+	cust.BillingAddress = AddressFromApiV1(c.GetBillingAddress())
+	return cust
+}
+
+type Customer struct {
+	ID              uuid.UUID
+	Name            string
+	Email           string
+	Phone           sql.NullString
+	ShippingAddress *Address
+	BillingAddress  *Address
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	DeletedAt       sql.NullTime
+	ErasedAt        sql.NullTime
+	Version         int64
+}
+
+// Validate checks whether customer data is complete and well formatted.
+func (c *Customer) Validate() error {
+	if c.Name == "" {
+		return ErrInvalidCustomer{Message: "customer name is required"}
+	}
+	if c.Email == "" {
+		return ErrInvalidCustomer{Message: "customer email is required"}
+	}
+	addr, err := mail.ParseAddress(c.Email)
+	if err != nil || addr.Address != c.Email {
+		return ErrInvalidEmail
+	}
+	if c.Phone.Valid && !e164Pattern.MatchString(c.Phone.String) {
+		return ErrInvalidPhoneNumber
+	}
+	return nil
+}
+
+// Update replaces the customer fields listed in paths with the values from the given customer.
+// All updatable fields are replaced when paths is empty.
+func (c *Customer) Update(from *v1.Customer, paths []string) error {
+	if len(paths) == 0 {
+		paths = []string{"name", "email", "phone_number", "shipping_address", "billing_address"}
+	}
+	for _, p := range paths {
+		switch p {
+		case "name":
+			c.Name = strings.TrimSpace(from.GetName())
+		case "email":
+			c.Email = normalizeEmail(from.GetEmail())
+		case "phone_number":
+			c.Phone = normalizePhone(from.GetPhoneNumber())
+		case "shipping_address":
+			c.ShippingAddress = AddressFromApiV1(from.GetShippingAddress())
+		case "billing_address":
+			c.BillingAddress = AddressFromApiV1(from.GetBillingAddress())
+		default:
+			return ErrInvalidCustomer{Message: "field " + p + " can not be updated"}
+		}
+	}
+	c.UpdatedAt = time.Now()
+	return c.Validate()
+}
+
+// Erase removes all personal data of the customer. An erased customer is
+// treated as deleted, but the record is kept to preserve booking references.
+func (c *Customer) Erase(erasedAt time.Time) {
+	c.Name = ""
+	c.Email = ""
+	c.Phone = sql.NullString{}
+	c.ShippingAddress = nil
+	c.BillingAddress = nil
+	c.UpdatedAt = erasedAt
+	c.ErasedAt = sql.NullTime{Time: erasedAt, Valid: true}
+	c.DeletedAt = sql.NullTime{Time: erasedAt, Valid: true}
+}
+
+func (c Customer) ApiV1() *v1.Customer {
+	return &v1.Customer{
+		CustomerId:      c.ID.String(),
+		Name:            c.Name,
+		Email:           c.Email,
+		PhoneNumber:     c.Phone.String,
+		ShippingAddress: c.ShippingAddress.ApiV1(),
+		BillingAddress:  c.BillingAddress.ApiV1(),
+		CreatedAt:       timestamppb.New(c.CreatedAt),
+		UpdatedAt:       timestamppb.New(c.UpdatedAt),
+	}
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// normalizePhone removes common separators so that numbers like
+// "+62 812-3456-7890" are stored as "+6281234567890".
+func normalizePhone(phone string) sql.NullString {
+	phone = strings.NewReplacer(" ", "", "-", "", "(", "", ")", "").Replace(phone)
+	if phone == "" {
+		return sql.NullString{}
+	}
+	return sql.NullString{Valid: true, String: phone}
+}
@@ -0,0 +1,36 @@
+package customer
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrInvalidEmail       = ErrInvalidCustomer{Message: "customer email is not a valid email address"}
+	ErrInvalidPhoneNumber = ErrInvalidCustomer{Message: "customer phone number must be in E.164 format"}
+	ErrEmailAlreadyUsed   = ErrCustomerAlreadyExists{Message: "customer with the same email already exists"}
+)
+
+type ErrInvalidCustomer struct {
+	Message string
+}
+
+func (e ErrInvalidCustomer) Error() string {
+	return e.Message
+}
+
+func (e ErrInvalidCustomer) GRPCStatus() *status.Status {
+	return status.New(codes.InvalidArgument, e.Error())
+}
+
+type ErrCustomerAlreadyExists struct {
+	Message string
+}
+
+func (e ErrCustomerAlreadyExists) Error() string {
+	return e.Message
+}
+
+func (e ErrCustomerAlreadyExists) GRPCStatus() *status.Status {
+	return status.New(codes.AlreadyExists, e.Error())
+}
@@ -0,0 +1,39 @@
+package customer
+
+import "github.com/jmoiron/sqlx"
+
+type FindOptions struct {
+	Tx *sqlx.Tx
+}
+
+type FindOption func(*FindOptions)
+
+func WithFindTx(tx *sqlx.Tx) FindOption {
+	return func(o *FindOptions) {
+		o.Tx = tx
+	}
+}
+
+type CreateOptions struct {
+	Tx *sqlx.Tx
+}
+
+type CreateOption func(*CreateOptions)
+
+func WithCreateTx(tx *sqlx.Tx) CreateOption {
+	return func(o *CreateOptions) {
+		o.Tx = tx
+	}
+}
+
+type UpdateOptions struct {
+	Tx *sqlx.Tx
+}
+
+type UpdateOption func(*UpdateOptions)
+
+func WithUpdateTx(tx *sqlx.Tx) UpdateOption {
+	return func(o *UpdateOptions) {
+		o.Tx = tx
+	}
+}
@@ -0,0 +1,180 @@
+package customer
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/imrenagicom/demo-app/internal/audit"
+	"github.com/imrenagicom/demo-app/internal/auth"
+	"github.com/imrenagicom/demo-app/internal/db"
+	v1 "github.com/imrenagicom/demo-app/pkg/apiclient/course/v1"
+
+	"github.com/jmoiron/sqlx"
+)
+
+func NewService(db *sqlx.DB, store *Store, auditStore *audit.Store) *Service {
+	return &Service{
+		db:         db,
+		store:      store,
+		auditStore: auditStore,
+	}
+}
+
+type Service struct {
+	db         *sqlx.DB
+	store      *Store
+	auditStore *audit.Store
+}
+
+func (s Service) CreateCustomer(ctx context.Context, req *v1.CreateCustomerRequest) (*Customer, error) {
+	c := FromApiV1(req.GetCustomer())
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.store.CreateCustomer(ctx, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// GetCustomer returns the customer to that customer, authenticated by a
+// customer token, or to an admin.
+func (s Service) GetCustomer(ctx context.Context, req *v1.GetCustomerRequest) (*Customer, error) {
+	if err := auth.RequireCustomer(ctx, req.GetCustomer()); err != nil {
+		return nil, err
+	}
+	return s.store.FindCustomerByID(ctx, req.GetCustomer())
+}
+
+// UpdateCustomer updates the profile of the customer. Only that customer and
+// admins may update it.
+func (s Service) UpdateCustomer(ctx context.Context, req *v1.UpdateCustomerRequest) (*Customer, error) {
+	if err := auth.RequireCustomer(ctx, req.GetCustomer().GetCustomerId()); err != nil {
+		return nil, err
+	}
+	c, err := s.store.FindCustomerByID(ctx, req.GetCustomer().GetCustomerId())
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Update(req.GetCustomer(), req.GetUpdateMask().GetPaths()); err != nil {
+		return nil, err
+	}
+	if err := s.store.UpdateCustomer(ctx, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// FindOrCreate returns the customer of a booking. A customer_id is only
+// accepted from the customer it identifies, authenticated by a customer token,
+// or from an admin. Otherwise the customer is looked up by email and created
+// if it does not exist yet.
+//
+// The profile of an existing customer is updated with the given details when
+// the caller is authenticated as that customer. For other callers the profile
+// is left as is, and the returned customer has the given details, so that
+// they are still used for the booking.
+func (s Service) FindOrCreate(ctx context.Context, req *v1.Customer) (*Customer, error) {
+	if req.GetCustomerId() != "" {
+		if err := auth.RequireCustomer(ctx, req.GetCustomerId()); err != nil {
+			return nil, err
+		}
+		return s.store.FindCustomerByID(ctx, req.GetCustomerId())
+	}
+
+	c := FromApiV1(req)
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.store.FindCustomerByEmail(ctx, c.Email)
+	if err == nil {
+		return s.withDetails(ctx, existing, req)
+	}
+	var notFound db.ErrResourceNotFound
+	if !errors.As(err, &notFound) {
+		return nil, err
+	}
+
+	err = s.store.CreateCustomer(ctx, c)
+	if errors.Is(err, ErrEmailAlreadyUsed) {
+		// created concurrently by another request
+		existing, err = s.store.FindCustomerByEmail(ctx, c.Email)
+		if err != nil {
+			return nil, err
+		}
+		return s.withDetails(ctx, existing, req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// withDetails returns the existing customer with the details given for a
+// booking. They are only stored when the caller is that customer.
+func (s Service) withDetails(ctx context.Context, existing *Customer, req *v1.Customer) (*Customer, error) {
+	paths := []string{"name"}
+	if req.GetPhoneNumber() != "" {
+		paths = append(paths, "phone_number")
+	}
+	if req.GetShippingAddress() != nil {
+		paths = append(paths, "shipping_address")
+	}
+	if req.GetBillingAddress() != nil {
+		paths = append(paths, "billing_address")
+	}
+	c := *existing
+	if err := c.Update(req, paths); err != nil {
+		return nil, err
+	}
+	if id, ok := auth.CustomerFromContext(ctx); !ok || id != existing.ID.String() {
+		return &c, nil
+	}
+	if err := s.store.UpdateCustomer(ctx, &c); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// EraseCustomer anonymizes the customer and the customer snapshot of all of its
+// bookings, and leaves an audit entry. Everything is done in a single transaction.
+func (s Service) EraseCustomer(ctx context.Context, req *v1.EraseCustomerRequest) (int64, error) {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	c, err := s.store.FindCustomerByID(ctx, req.GetCustomer(), WithFindTx(tx))
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	c.Erase(time.Now())
+	if err = s.store.EraseCustomer(ctx, c, WithUpdateTx(tx)); err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	n, err := s.store.AnonymizeBookings(ctx, c.ID.String(), WithUpdateTx(tx))
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	entry := audit.NewEntry(ctx, "customer.erase", "customers/"+c.ID.String(), map[string]any{
+		"reason":              req.GetReason(),
+		"anonymized_bookings": n,
+	})
+	if err = s.auditStore.Record(ctx, entry, audit.WithRecordTx(tx)); err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
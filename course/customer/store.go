@@ -0,0 +1,223 @@
+package customer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/imrenagicom/demo-app/internal/db"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/redis/go-redis/v9"
+)
+
+func NewStore(db *sqlx.DB, redis redis.UniversalClient) *Store {
+	return &Store{
+		db:      db,
+		dbCache: sq.NewStmtCache(db),
+		redis:   redis,
+	}
+}
+
+type Store struct {
+	db      *sqlx.DB
+	dbCache *sq.StmtCache
+	redis   redis.UniversalClient
+}
+
+func (s *Store) Clear() error {
+	return s.dbCache.Clear()
+}
+
+var customerColumns = []string{
+	"id", "name", "email", "phone", "shipping_address", "billing_address", "created_at", "updated_at", "version",
+}
+
+func scanCustomer(row sq.RowScanner) (*Customer, error) {
+	var c Customer
+	var email sql.NullString
+	var shipping, billing nullAddress
+	if err := row.Scan(&c.ID, &c.Name, &email, &c.Phone, &shipping, &billing, &c.CreatedAt, &c.UpdatedAt, &c.Version); err != nil {
+		return nil, err
+	}
+	c.Email = email.String
+	c.ShippingAddress = shipping.Address
+	c.BillingAddress = billing.Address
+	return &c, nil
+}
+
+func (s *Store) CreateCustomer(ctx context.Context, c *Customer, opts ...CreateOption) error {
+	options := &CreateOptions{}
+	for _, o := range opts {
+		o(options)
+	}
+
+	sb := sq.StatementBuilder.RunWith(s.dbCache)
+	if options.Tx != nil {
+		sb = sb.RunWith(options.Tx)
+	}
+	insertCustomer := sb.Insert("customers").
+		Columns("id", "name", "email", "phone", "shipping_address", "billing_address", "created_at", "updated_at").
+		Values(c.ID, c.Name, c.Email, c.Phone, c.ShippingAddress, c.BillingAddress, c.CreatedAt, c.UpdatedAt).
+		PlaceholderFormat(sq.Dollar)
+
+	_, err := insertCustomer.ExecContext(ctx)
+	if db.IsUniqueViolation(err) {
+		return ErrEmailAlreadyUsed
+	}
+	return err
+}
+
+func (s *Store) FindCustomerByID(ctx context.Context, id string, opts ...FindOption) (*Customer, error) {
+	notFoundMsg := fmt.Sprintf("customer with id %s not found", id)
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, db.ErrResourceNotFound{Message: notFoundMsg}
+	}
+	return s.findCustomer(ctx, sq.Eq{"id": id}, notFoundMsg, opts...)
+}
+
+func (s *Store) FindCustomerByEmail(ctx context.Context, email string, opts ...FindOption) (*Customer, error) {
+	return s.findCustomer(ctx, sq.Eq{"email": normalizeEmail(email)}, fmt.Sprintf("customer with email %s not found", email), opts...)
+}
+
+func (s *Store) findCustomer(ctx context.Context, filter sq.Eq, notFoundMsg string, opts ...FindOption) (*Customer, error) {
+	options := &FindOptions{}
+	for _, o := range opts {
+		o(options)
+	}
+
+	sb := sq.StatementBuilder.RunWith(s.dbCache)
+	if options.Tx != nil {
+		sb = sb.RunWith(options.Tx)
+	}
+	filter["deleted_at"] = nil
+	query := sb.Select(customerColumns...).
+		From("customers").
+		Where(filter).
+		PlaceholderFormat(sq.Dollar)
+
+	c, err := scanCustomer(query.QueryRowContext(ctx))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, db.ErrResourceNotFound{Message: notFoundMsg}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (s *Store) UpdateCustomer(ctx context.Context, c *Customer, opts ...UpdateOption) error {
+	options := &UpdateOptions{}
+	for _, o := range opts {
+		o(options)
+	}
+
+	sb := sq.StatementBuilder.RunWith(s.dbCache)
+	if options.Tx != nil {
+		sb = sb.RunWith(options.Tx)
+	}
+	updateCustomer := sb.Update("customers").
+		Set("name", c.Name).
+		Set("email", c.Email).
+		Set("phone", c.Phone).
+		Set("shipping_address", c.ShippingAddress).
+		Set("billing_address", c.BillingAddress).
+		Set("updated_at", c.UpdatedAt).
+		Set("version", c.Version+1).
+		Where(sq.Eq{"id": c.ID, "version": c.Version}).
+		PlaceholderFormat(sq.Dollar)
+
+	res, err := updateCustomer.ExecContext(ctx)
+	if db.IsUniqueViolation(err) {
+		return ErrEmailAlreadyUsed
+	}
+	if err != nil {
+		return err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return db.ErrNoRowUpdated
+	}
+	c.Version++
+	return nil
+}
+
+// EraseCustomer persists an erased customer. The email is set to null so that
+// it can be used again by a new customer.
+func (s *Store) EraseCustomer(ctx context.Context, c *Customer, opts ...UpdateOption) error {
+	options := &UpdateOptions{}
+	for _, o := range opts {
+		o(options)
+	}
+
+	sb := sq.StatementBuilder.RunWith(s.dbCache)
+	if options.Tx != nil {
+		sb = sb.RunWith(options.Tx)
+	}
+	eraseCustomer := sb.Update("customers").
+		Set("name", c.Name).
+		Set("email", nil).
+		Set("phone", nil).
+		Set("shipping_address", nil).
+		Set("billing_address", nil).
+		Set("updated_at", c.UpdatedAt).
+		Set("erased_at", c.ErasedAt).
+		Set("deleted_at", c.DeletedAt).
+		Set("version", c.Version+1).
+		Where(sq.Eq{"id": c.ID, "version": c.Version}).
+		PlaceholderFormat(sq.Dollar)
+
+	res, err := eraseCustomer.ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return db.ErrNoRowUpdated
+	}
+	c.Version++
+	return nil
+}
+
+// AnonymizeBookings clears the customer snapshot (cust_* columns) of all
+// bookings made by the customer. Other booking data, including price, payment
+// and invoice, are left untouched. It returns the number of updated bookings.
+func (s *Store) AnonymizeBookings(ctx context.Context, customerID string, opts ...UpdateOption) (int64, error) {
+	options := &UpdateOptions{}
+	for _, o := range opts {
+		o(options)
+	}
+
+	sb := sq.StatementBuilder.RunWith(s.dbCache)
+	if options.Tx != nil {
+		sb = sb.RunWith(options.Tx)
+	}
+	anonymizeBookings := sb.Update("bookings").
+		Set("cust_name", "").
+		Set("cust_email", "").
+		Set("cust_phone", nil).
+		Set("updated_at", time.Now()).
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"customer_id": customerID}).
+		PlaceholderFormat(sq.Dollar)
+
+	res, err := anonymizeBookings.ExecContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
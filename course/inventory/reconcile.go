@@ -0,0 +1,364 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/imrenagicom/demo-app/course/booking"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/rs/zerolog/log"
+)
+
+// activeStatuses are booking statuses holding a seat of the batch.
+var activeStatuses = []booking.Status{booking.StatusReserved, booking.StatusCompleted}
+
+// errDryRun is used to roll back the transaction of a dry run.
+var errDryRun = errors.New("dry run")
+
+// Drift describes a batch whose available seats do not match its bookings.
+type Drift struct {
+	BatchID  uuid.UUID `json:"batch_id"`
+	CourseID uuid.UUID `json:"course_id"`
+	MaxSeats int32     `json:"max_seats"`
+	// AvailableSeats is the number of available seats stored on the batch.
+	AvailableSeats int32 `json:"available_seats"`
+	// ActiveBookings is the number of reserved and completed bookings.
+	ActiveBookings int32 `json:"active_bookings"`
+	// ExpectedAvailableSeats is MaxSeats minus ActiveBookings.
+	ExpectedAvailableSeats int32 `json:"expected_available_seats"`
+	// Oversold is true when there are more active bookings than seats.
+	Oversold bool `json:"oversold"`
+	Fixed    bool `json:"fixed"`
+}
+
+// Delta is the number of seats to add to the stored available seats to match
+// the bookings. It is negative when the batch offers more seats than it has.
+func (d Drift) Delta() int32 {
+	return d.target() - d.AvailableSeats
+}
+
+func (d Drift) target() int32 {
+	if d.ExpectedAvailableSeats < 0 {
+		return 0
+	}
+	return d.ExpectedAvailableSeats
+}
+
+type Report struct {
+	StartedAt      time.Time `json:"started_at"`
+	FinishedAt     time.Time `json:"finished_at"`
+	Fix            bool      `json:"fix"`
+	DryRun         bool      `json:"dry_run"`
+	BatchesChecked int       `json:"batches_checked"`
+	Drifts         []Drift   `json:"drifts"`
+}
+
+func (r Report) HasDrift() bool {
+	return len(r.Drifts) > 0
+}
+
+type Options struct {
+	Fix      bool
+	DryRun   bool
+	BatchIDs []string
+}
+
+type Option func(*Options)
+
+// WithFix corrects the available seats of the drifted batches in a single transaction.
+func WithFix() Option {
+	return func(o *Options) {
+		o.Fix = true
+	}
+}
+
+// WithDryRun runs the fix, but rolls the transaction back at the end.
+func WithDryRun() Option {
+	return func(o *Options) {
+		o.DryRun = true
+	}
+}
+
+// WithBatches limits the check to the given batches.
+func WithBatches(ids ...string) Option {
+	return func(o *Options) {
+		o.BatchIDs = append(o.BatchIDs, ids...)
+	}
+}
+
+// SeatCache is the redis seat counter of the batches, see catalog.Store.
+type SeatCache interface {
+	SetSeatsInCache(ctx context.Context, batchID uuid.UUID, available int32) error
+}
+
+type ReconcilerOption func(*Reconciler)
+
+// WithSeatCache corrects the seat counter of every fixed batch in cache as
+// well, so that it matches postgres before it expires.
+func WithSeatCache(cache SeatCache) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.cache = cache
+	}
+}
+
+func NewReconciler(db *sqlx.DB, opts ...ReconcilerOption) *Reconciler {
+	r := &Reconciler{
+		db: db,
+	}
+	for _, o := range opts {
+		o(r)
+	}
+	return r
+}
+
+// Reconciler checks that available seats of every batch with limited seats is
+// equal to its max seats minus its active bookings.
+type Reconciler struct {
+	db    *sqlx.DB
+	cache SeatCache
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, opts ...Option) (*Report, error) {
+	options := &Options{}
+	for _, o := range opts {
+		o(options)
+	}
+
+	report := &Report{
+		StartedAt: time.Now(),
+		Fix:       options.Fix,
+		DryRun:    options.DryRun,
+		Drifts:    []Drift{},
+	}
+
+	drifts, checked, err := r.findDrifts(ctx, r.db, options.BatchIDs, false)
+	if err != nil {
+		return nil, err
+	}
+	report.BatchesChecked = checked
+	report.Drifts = drifts
+
+	if options.Fix && len(drifts) > 0 {
+		fixed, err := r.fix(ctx, drifts, options.DryRun)
+		if err != nil {
+			return nil, err
+		}
+		report.Drifts = fixed
+	}
+	report.FinishedAt = time.Now()
+	return report, nil
+}
+
+// findDrifts reads the batches, locking them when forUpdate is set, and then
+// counts their active bookings. The bookings are counted by a second
+// statement, so that under READ COMMITTED they are read from a snapshot taken
+// after the locks were granted, which includes the bookings committed by the
+// transactions the locks waited for.
+func (r *Reconciler) findDrifts(ctx context.Context, runner sq.BaseRunner, batchIDs []string, forUpdate bool) ([]Drift, int, error) {
+	filter := sq.And{
+		sq.Eq{"cb.deleted_at": nil},
+		sq.Gt{"cb.max_seats": 0},
+	}
+	if len(batchIDs) > 0 {
+		filter = append(filter, sq.Eq{"cb.id": batchIDs})
+	}
+
+	sb := sq.StatementBuilder.RunWith(runner).PlaceholderFormat(sq.Dollar)
+	query := sb.Select("cb.id", "cb.course_id", "cb.max_seats", "cb.available_seats").
+		From("course_batches cb").
+		Where(filter).
+		OrderBy("cb.id")
+	if forUpdate {
+		query = query.Suffix("FOR UPDATE OF cb")
+	}
+
+	batches, err := scanBatches(ctx, query)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(batches) == 0 {
+		return []Drift{}, 0, nil
+	}
+
+	ids := make([]string, 0, len(batches))
+	for _, d := range batches {
+		ids = append(ids, d.BatchID.String())
+	}
+	active, err := countActiveBookings(ctx, sb, ids)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	drifts := []Drift{}
+	for _, d := range batches {
+		d.ActiveBookings = active[d.BatchID]
+		d.ExpectedAvailableSeats = d.MaxSeats - d.ActiveBookings
+		d.Oversold = d.ExpectedAvailableSeats < 0
+		if d.Delta() != 0 || d.Oversold {
+			drifts = append(drifts, d)
+		}
+	}
+	return drifts, len(batches), nil
+}
+
+func scanBatches(ctx context.Context, query sq.SelectBuilder) ([]Drift, error) {
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var batches []Drift
+	for rows.Next() {
+		var d Drift
+		if err := rows.Scan(&d.BatchID, &d.CourseID, &d.MaxSeats, &d.AvailableSeats); err != nil {
+			return nil, err
+		}
+		batches = append(batches, d)
+	}
+	return batches, rows.Err()
+}
+
+// countActiveBookings returns the number of active bookings of the batches.
+// Batches without active bookings are missing from the result.
+func countActiveBookings(ctx context.Context, sb sq.StatementBuilderType, batchIDs []string) (map[uuid.UUID]int32, error) {
+	rows, err := sb.Select("b.course_batch_id", "COUNT(*)").
+		From("bookings b").
+		Where("b.course_batch_id = ANY(?)", pq.Array(batchIDs)).
+		Where(sq.Eq{"b.deleted_at": nil, "b.status": activeStatuses}).
+		GroupBy("b.course_batch_id").
+		QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	active := make(map[uuid.UUID]int32, len(batchIDs))
+	for rows.Next() {
+		var id uuid.UUID
+		var n int32
+		if err := rows.Scan(&id, &n); err != nil {
+			return nil, err
+		}
+		active[id] = n
+	}
+	return active, rows.Err()
+}
+
+// fix locks the drifted batches, computes their drift again and corrects
+// their available seats. Every reservation and release updates the batch row
+// in the same transaction as the booking, so once the row locks are held no
+// booking of the batches changes until the fix is committed, and the bookings
+// counted after the locks were granted are the ones the fix is based on.
+func (r *Reconciler) fix(ctx context.Context, drifts []Drift, dryRun bool) ([]Drift, error) {
+	var ids []string
+	for _, d := range drifts {
+		ids = append(ids, d.BatchID.String())
+	}
+
+	var fixed []Drift
+	err := r.inTx(ctx, func(tx *sqlx.Tx) error {
+		locked, _, err := r.findDrifts(ctx, tx, ids, true)
+		if err != nil {
+			return err
+		}
+		for _, d := range locked {
+			if d.Delta() != 0 {
+				update := sq.StatementBuilder.RunWith(tx).
+					Update("course_batches").
+					Set("available_seats", d.target()).
+					Set("version", sq.Expr("version + 1")).
+					Set("updated_at", time.Now()).
+					Where(sq.Eq{"id": d.BatchID}).
+					PlaceholderFormat(sq.Dollar)
+				if _, err := update.ExecContext(ctx); err != nil {
+					return err
+				}
+				d.Fixed = !dryRun
+			}
+			fixed = append(fixed, d)
+		}
+		if dryRun {
+			return errDryRun
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errDryRun) {
+		return nil, err
+	}
+	if !dryRun {
+		r.correctCache(ctx, fixed)
+	}
+	return fixed, nil
+}
+
+// correctCache sets the seat counter of the fixed batches to their new
+// available seats. A failure is only logged, as the counter is synchronized
+// with postgres once it expires anyway.
+func (r *Reconciler) correctCache(ctx context.Context, drifts []Drift) {
+	if r.cache == nil {
+		return
+	}
+	for _, d := range drifts {
+		if !d.Fixed {
+			continue
+		}
+		if err := r.cache.SetSeatsInCache(ctx, d.BatchID, d.target()); err != nil {
+			log.Ctx(ctx).Warn().Err(err).Str("batch", d.BatchID.String()).Msg("unable to correct seat counter in cache")
+		}
+	}
+}
+
+func (r *Reconciler) inTx(ctx context.Context, fn func(tx *sqlx.Tx) error) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// defaultInterval is used by Run when the given interval is not positive.
+const defaultInterval = 5 * time.Minute
+
+// Run reconciles the seats every interval until ctx is done.
+func (r *Reconciler) Run(ctx context.Context, interval time.Duration, opts ...Option) {
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			report, err := r.Reconcile(ctx, opts...)
+			if err != nil {
+				log.Error().Err(err).Msg("unable to reconcile seat inventory")
+				continue
+			}
+			for _, d := range report.Drifts {
+				log.Warn().
+					Str("batch_id", d.BatchID.String()).
+					Int32("available_seats", d.AvailableSeats).
+					Int32("expected_available_seats", d.ExpectedAvailableSeats).
+					Bool("oversold", d.Oversold).
+					Bool("fixed", d.Fixed).
+					Msg("seat inventory drift detected")
+			}
+			log.Debug().
+				Int("batches_checked", report.BatchesChecked).
+				Int("drifts", len(report.Drifts)).
+				Msg("seat inventory reconciled")
+		}
+	}
+}
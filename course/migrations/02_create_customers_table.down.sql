@@ -0,0 +1,4 @@
+ALTER TABLE bookings
+    DROP CONSTRAINT IF EXISTS fk_customers_id,
+    DROP COLUMN IF EXISTS customer_id;
+DROP TABLE IF EXISTS customers;
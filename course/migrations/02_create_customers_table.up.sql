@@ -0,0 +1,35 @@
+CREATE TABLE IF NOT EXISTS customers
+(
+    id               UUID NOT NULL PRIMARY KEY,
+    name             VARCHAR NOT NULL default '',
+    email            VARCHAR,
+    phone            VARCHAR,
+    shipping_address JSONB,
+    billing_address  JSONB,
+    created_at       TIMESTAMP with time zone default now(),
+    updated_at       TIMESTAMP with time zone default now(),
+    deleted_at       TIMESTAMP with time zone,
+    version          BIGINT                   default 0,
+    UNIQUE (email)
+);
+
+CREATE INDEX IF NOT EXISTS idx_customers_deleted_at on customers (deleted_at);
+
+ALTER TABLE bookings
+    ADD COLUMN IF NOT EXISTS customer_id UUID,
+    ADD CONSTRAINT fk_customers_id FOREIGN KEY (customer_id) references customers;
+CREATE INDEX IF NOT EXISTS idx_bookings_customer_id on bookings (customer_id);
+
+-- link existing bookings to customers identified by their email address
+INSERT INTO customers (id, name, email, phone, created_at, updated_at)
+SELECT DISTINCT ON (lower(cust_email)) gen_random_uuid(), cust_name, lower(cust_email), cust_phone, created_at, created_at
+FROM bookings
+WHERE cust_email <> ''
+ORDER BY lower(cust_email), created_at DESC
+ON CONFLICT (email) DO NOTHING;
+
+UPDATE bookings b
+SET customer_id = c.id
+FROM customers c
+WHERE c.email = lower(b.cust_email)
+  AND b.customer_id IS NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE customers
+    DROP COLUMN IF EXISTS erased_at;
+DROP TABLE IF EXISTS audit_entries;
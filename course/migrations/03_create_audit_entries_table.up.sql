@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS audit_entries
+(
+    id         UUID    NOT NULL PRIMARY KEY,
+    actor      VARCHAR NOT NULL,
+    action     VARCHAR NOT NULL,
+    resource   VARCHAR NOT NULL,
+    details    JSONB,
+    created_at TIMESTAMP with time zone default now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_entries_resource on audit_entries (resource);
+CREATE INDEX IF NOT EXISTS idx_audit_entries_created_at on audit_entries (created_at);
+
+ALTER TABLE customers
+    ADD COLUMN IF NOT EXISTS erased_at TIMESTAMP with time zone;
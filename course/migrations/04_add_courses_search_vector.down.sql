@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_course_batches_start_date;
+DROP INDEX IF EXISTS idx_courses_search_vector;
+ALTER TABLE courses
+    DROP COLUMN IF EXISTS search_vector;
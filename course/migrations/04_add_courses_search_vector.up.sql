@@ -0,0 +1,9 @@
+ALTER TABLE courses
+    ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('english'::regconfig, coalesce(name, '')), 'A') ||
+        setweight(to_tsvector('english'::regconfig, replace(coalesce(slug, ''), '-', ' ')), 'B') ||
+        setweight(to_tsvector('english'::regconfig, coalesce(description, '')), 'C')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_courses_search_vector on courses USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_course_batches_start_date on course_batches (start_date);
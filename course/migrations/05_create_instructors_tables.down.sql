@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS batch_instructors;
+DROP TABLE IF EXISTS course_instructors;
+DROP TABLE IF EXISTS instructors;
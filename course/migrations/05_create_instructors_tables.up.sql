@@ -0,0 +1,39 @@
+CREATE TABLE IF NOT EXISTS instructors
+(
+    id         UUID NOT NULL PRIMARY KEY,
+    name       VARCHAR NOT NULL,
+    image_url  VARCHAR,
+    bio        TEXT,
+    created_at TIMESTAMP with time zone default now(),
+    updated_at TIMESTAMP with time zone default now(),
+    deleted_at TIMESTAMP with time zone,
+    version    BIGINT default 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_instructors_deleted_at on instructors (deleted_at);
+
+CREATE TABLE IF NOT EXISTS course_instructors
+(
+    course_id     UUID NOT NULL,
+    instructor_id UUID NOT NULL,
+    roles         VARCHAR[] NOT NULL default '{}',
+    created_at    TIMESTAMP with time zone default now(),
+    PRIMARY KEY (course_id, instructor_id),
+    CONSTRAINT fk_courses_id FOREIGN KEY (course_id) references courses ON DELETE CASCADE,
+    CONSTRAINT fk_instructors_id FOREIGN KEY (instructor_id) references instructors ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_course_instructors_instructor_id on course_instructors (instructor_id);
+
+CREATE TABLE IF NOT EXISTS batch_instructors
+(
+    batch_id      UUID NOT NULL,
+    instructor_id UUID NOT NULL,
+    roles         VARCHAR[] NOT NULL default '{}',
+    created_at    TIMESTAMP with time zone default now(),
+    PRIMARY KEY (batch_id, instructor_id),
+    CONSTRAINT fk_course_batches_id FOREIGN KEY (batch_id) references course_batches ON DELETE CASCADE,
+    CONSTRAINT fk_instructors_id FOREIGN KEY (instructor_id) references instructors ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_batch_instructors_instructor_id on batch_instructors (instructor_id);
@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS trg_course_batches_refresh_course_prices ON course_batches;
+DROP FUNCTION IF EXISTS course_batches_refresh_course_prices();
+DROP FUNCTION IF EXISTS refresh_course_prices(UUID);
+DROP TABLE IF EXISTS course_prices;
@@ -0,0 +1,93 @@
+-- course_prices keeps the pricing summary of every course per currency. It is
+-- maintained by a trigger on course_batches so that courses can be listed
+-- with their prices without loading the batches.
+CREATE TABLE IF NOT EXISTS course_prices
+(
+    course_id                   UUID    NOT NULL,
+    currency                    VARCHAR NOT NULL,
+    min_price                   DOUBLE PRECISION NOT NULL,
+    max_price                   DOUBLE PRECISION NOT NULL,
+    cheapest_available_batch_id UUID,
+    cheapest_available_price    DOUBLE PRECISION,
+    updated_at                  TIMESTAMP with time zone default now(),
+    PRIMARY KEY (course_id, currency),
+    CONSTRAINT fk_courses_id FOREIGN KEY (course_id) references courses ON DELETE CASCADE
+);
+
+-- refresh_course_prices recomputes the pricing summary of a course from its
+-- published batches. A batch is available when it has seats left and has not
+-- ended at the time the summary is refreshed.
+CREATE OR REPLACE FUNCTION refresh_course_prices(p_course_id UUID) RETURNS void AS
+$$
+BEGIN
+    IF p_course_id IS NULL THEN
+        RETURN;
+    END IF;
+
+    -- serialize refreshes of the same course so that concurrent batch updates
+    -- do not overwrite each other with a stale summary.
+    PERFORM pg_advisory_xact_lock(hashtextextended('course_prices:' || p_course_id::text, 0));
+
+    DELETE
+    FROM course_prices cp
+    WHERE cp.course_id = p_course_id
+      AND NOT EXISTS (SELECT 1
+                      FROM course_batches cb
+                      WHERE cb.course_id = p_course_id
+                        AND cb.currency = cp.currency
+                        AND cb.price IS NOT NULL
+                        AND cb.status = 1
+                        AND cb.deleted_at IS NULL);
+
+    INSERT INTO course_prices (course_id, currency, min_price, max_price, cheapest_available_batch_id,
+                               cheapest_available_price, updated_at)
+    SELECT b.course_id,
+           b.currency,
+           min(b.price),
+           max(b.price),
+           (array_agg(b.id ORDER BY b.price, b.start_date NULLS LAST) FILTER (WHERE b.available))[1],
+           min(b.price) FILTER (WHERE b.available),
+           now()
+    FROM (SELECT cb.*,
+                 (cb.max_seats <= 0 OR cb.available_seats > 0) AND
+                 (cb.end_date IS NULL OR cb.end_date > now()) AS available
+          FROM course_batches cb
+          WHERE cb.course_id = p_course_id
+            AND cb.currency IS NOT NULL
+            AND cb.price IS NOT NULL
+            AND cb.status = 1
+            AND cb.deleted_at IS NULL) b
+    GROUP BY b.course_id, b.currency
+    ON CONFLICT (course_id, currency) DO UPDATE
+        SET min_price                   = EXCLUDED.min_price,
+            max_price                   = EXCLUDED.max_price,
+            cheapest_available_batch_id = EXCLUDED.cheapest_available_batch_id,
+            cheapest_available_price    = EXCLUDED.cheapest_available_price,
+            updated_at                  = EXCLUDED.updated_at;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION course_batches_refresh_course_prices() RETURNS trigger AS
+$$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        PERFORM refresh_course_prices(OLD.course_id);
+        RETURN NULL;
+    END IF;
+    IF TG_OP = 'UPDATE' AND OLD.course_id IS DISTINCT FROM NEW.course_id THEN
+        PERFORM refresh_course_prices(OLD.course_id);
+    END IF;
+    PERFORM refresh_course_prices(NEW.course_id);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_course_batches_refresh_course_prices ON course_batches;
+CREATE TRIGGER trg_course_batches_refresh_course_prices
+    AFTER INSERT OR DELETE OR UPDATE OF course_id, price, currency, status, deleted_at, max_seats, available_seats, end_date
+    ON course_batches
+    FOR EACH ROW
+EXECUTE FUNCTION course_batches_refresh_course_prices();
+
+SELECT refresh_course_prices(id)
+FROM courses;
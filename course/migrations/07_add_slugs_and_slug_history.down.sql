@@ -0,0 +1,9 @@
+DROP TRIGGER IF EXISTS trg_course_batches_record_slug_history ON course_batches;
+DROP FUNCTION IF EXISTS course_batches_record_slug_history();
+DROP TRIGGER IF EXISTS trg_courses_record_slug_history ON courses;
+DROP FUNCTION IF EXISTS courses_record_slug_history();
+DROP TABLE IF EXISTS batch_slug_history;
+DROP TABLE IF EXISTS course_slug_history;
+DROP INDEX IF EXISTS idx_course_batches_course_id_slug;
+ALTER TABLE course_batches
+    DROP COLUMN IF EXISTS slug;
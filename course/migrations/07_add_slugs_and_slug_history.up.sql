@@ -0,0 +1,78 @@
+ALTER TABLE course_batches
+    ADD COLUMN IF NOT EXISTS slug VARCHAR;
+
+-- derive slugs of existing batches from their names. Batches of the same
+-- course sharing a name get part of their id appended.
+UPDATE course_batches cb
+SET slug = s.slug
+FROM (SELECT id,
+             CASE
+                 WHEN row_number() OVER (PARTITION BY course_id, base ORDER BY created_at, id) = 1 THEN base
+                 ELSE base || '-' || left(id::text, 8)
+                 END AS slug
+      FROM (SELECT id,
+                   course_id,
+                   created_at,
+                   coalesce(nullif(trim(BOTH '-' FROM regexp_replace(lower(coalesce(name, '')), '[^a-z0-9]+', '-', 'g')), ''),
+                            'batch') AS base
+            FROM course_batches) b) s
+WHERE cb.id = s.id
+  AND cb.slug IS NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_course_batches_course_id_slug on course_batches (course_id, slug) WHERE deleted_at IS NULL;
+
+-- slug history keeps the previous slugs so that old resource names can be
+-- redirected to the current ones.
+CREATE TABLE IF NOT EXISTS course_slug_history
+(
+    slug       VARCHAR NOT NULL PRIMARY KEY,
+    course_id  UUID    NOT NULL,
+    created_at TIMESTAMP with time zone default now(),
+    CONSTRAINT fk_courses_id FOREIGN KEY (course_id) references courses ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS batch_slug_history
+(
+    course_id  UUID    NOT NULL,
+    slug       VARCHAR NOT NULL,
+    batch_id   UUID    NOT NULL,
+    created_at TIMESTAMP with time zone default now(),
+    PRIMARY KEY (course_id, slug),
+    CONSTRAINT fk_course_batches_id FOREIGN KEY (batch_id) references course_batches ON DELETE CASCADE
+);
+
+CREATE OR REPLACE FUNCTION courses_record_slug_history() RETURNS trigger AS
+$$
+BEGIN
+    INSERT INTO course_slug_history (slug, course_id)
+    VALUES (OLD.slug, NEW.id)
+    ON CONFLICT (slug) DO UPDATE SET course_id = EXCLUDED.course_id, created_at = now();
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_courses_record_slug_history ON courses;
+CREATE TRIGGER trg_courses_record_slug_history
+    AFTER UPDATE OF slug
+    ON courses
+    FOR EACH ROW
+    WHEN (OLD.slug IS NOT NULL AND OLD.slug IS DISTINCT FROM NEW.slug)
+EXECUTE FUNCTION courses_record_slug_history();
+
+CREATE OR REPLACE FUNCTION course_batches_record_slug_history() RETURNS trigger AS
+$$
+BEGIN
+    INSERT INTO batch_slug_history (course_id, slug, batch_id)
+    VALUES (NEW.course_id, OLD.slug, NEW.id)
+    ON CONFLICT (course_id, slug) DO UPDATE SET batch_id = EXCLUDED.batch_id, created_at = now();
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_course_batches_record_slug_history ON course_batches;
+CREATE TRIGGER trg_course_batches_record_slug_history
+    AFTER UPDATE OF slug
+    ON course_batches
+    FOR EACH ROW
+    WHEN (OLD.slug IS NOT NULL AND OLD.slug IS DISTINCT FROM NEW.slug)
+EXECUTE FUNCTION course_batches_record_slug_history();
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_instructors_name_keyset;
+DROP INDEX IF EXISTS idx_bookings_created_keyset;
+DROP INDEX IF EXISTS idx_course_batches_course_created_keyset;
+DROP INDEX IF EXISTS idx_courses_created_keyset;
+DROP INDEX IF EXISTS idx_courses_published_keyset;
@@ -0,0 +1,5 @@
+CREATE INDEX IF NOT EXISTS idx_courses_published_keyset on courses ((COALESCE(published_at, created_at)), id);
+CREATE INDEX IF NOT EXISTS idx_courses_created_keyset on courses (created_at, id);
+CREATE INDEX IF NOT EXISTS idx_course_batches_course_created_keyset on course_batches (course_id, created_at, id);
+CREATE INDEX IF NOT EXISTS idx_bookings_created_keyset on bookings (created_at, id);
+CREATE INDEX IF NOT EXISTS idx_instructors_name_keyset on instructors (name, id);
@@ -0,0 +1,48 @@
+CREATE TABLE IF NOT EXISTS categories
+(
+    id          UUID    NOT NULL PRIMARY KEY,
+    parent_id   UUID,
+    name        VARCHAR NOT NULL,
+    slug        VARCHAR NOT NULL,
+    description TEXT,
+    created_at  TIMESTAMP with time zone default now(),
+    updated_at  TIMESTAMP with time zone default now(),
+    deleted_at  TIMESTAMP with time zone,
+    UNIQUE (slug),
+    CONSTRAINT fk_categories_parent_id FOREIGN KEY (parent_id) references categories
+);
+
+CREATE INDEX IF NOT EXISTS idx_categories_parent_id on categories (parent_id);
+
+CREATE TABLE IF NOT EXISTS course_categories
+(
+    course_id   UUID NOT NULL,
+    category_id UUID NOT NULL,
+    created_at  TIMESTAMP with time zone default now(),
+    PRIMARY KEY (course_id, category_id),
+    CONSTRAINT fk_courses_id FOREIGN KEY (course_id) references courses ON DELETE CASCADE,
+    CONSTRAINT fk_categories_id FOREIGN KEY (category_id) references categories ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_course_categories_category_id on course_categories (category_id);
+
+CREATE TABLE IF NOT EXISTS tags
+(
+    id         UUID    NOT NULL PRIMARY KEY,
+    name       VARCHAR NOT NULL,
+    slug       VARCHAR NOT NULL,
+    created_at TIMESTAMP with time zone default now(),
+    UNIQUE (slug)
+);
+
+CREATE TABLE IF NOT EXISTS course_tags
+(
+    course_id  UUID NOT NULL,
+    tag_id     UUID NOT NULL,
+    created_at TIMESTAMP with time zone default now(),
+    PRIMARY KEY (course_id, tag_id),
+    CONSTRAINT fk_courses_id FOREIGN KEY (course_id) references courses ON DELETE CASCADE,
+    CONSTRAINT fk_tags_id FOREIGN KEY (tag_id) references tags ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_course_tags_tag_id on course_tags (tag_id);
@@ -0,0 +1,8 @@
+DROP TRIGGER IF EXISTS trg_reviews_refresh_course_rating ON reviews;
+DROP FUNCTION IF EXISTS reviews_refresh_course_rating();
+DROP FUNCTION IF EXISTS refresh_course_rating(UUID);
+ALTER TABLE courses
+    DROP COLUMN IF EXISTS rating_average,
+    DROP COLUMN IF EXISTS review_count;
+DROP TABLE IF EXISTS review_revisions;
+DROP TABLE IF EXISTS reviews;
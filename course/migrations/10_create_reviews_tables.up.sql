@@ -0,0 +1,79 @@
+CREATE TABLE IF NOT EXISTS reviews
+(
+    id              UUID     NOT NULL PRIMARY KEY,
+    course_id       UUID     NOT NULL,
+    customer_id     UUID     NOT NULL,
+    rating          SMALLINT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    text            TEXT     NOT NULL default '',
+    state           INT      NOT NULL default 1,
+    moderation_note VARCHAR  NOT NULL default '',
+    edit_count      INT      NOT NULL default 0,
+    created_at      TIMESTAMP with time zone default now(),
+    updated_at      TIMESTAMP with time zone default now(),
+    edited_at       TIMESTAMP with time zone,
+    deleted_at      TIMESTAMP with time zone,
+    version         BIGINT                   default 0,
+    CONSTRAINT fk_courses_id FOREIGN KEY (course_id) references courses,
+    CONSTRAINT fk_customers_id FOREIGN KEY (customer_id) references customers
+);
+
+-- a customer reviews a course at most once, edits update the same review.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_reviews_course_id_customer_id on reviews (course_id, customer_id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_reviews_keyset on reviews (course_id, state, created_at DESC, id DESC) WHERE deleted_at IS NULL;
+
+-- review_revisions keeps the previous rating and text of a review every time it is edited.
+CREATE TABLE IF NOT EXISTS review_revisions
+(
+    id         BIGSERIAL NOT NULL PRIMARY KEY,
+    review_id  UUID      NOT NULL,
+    rating     SMALLINT  NOT NULL,
+    text       TEXT      NOT NULL,
+    created_at TIMESTAMP with time zone default now(),
+    CONSTRAINT fk_reviews_id FOREIGN KEY (review_id) references reviews ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_review_revisions_review_id on review_revisions (review_id, id);
+
+-- rating_average and review_count only count approved reviews. They are
+-- maintained by a trigger on reviews.
+ALTER TABLE courses
+    ADD COLUMN IF NOT EXISTS rating_average DOUBLE PRECISION NOT NULL default 0,
+    ADD COLUMN IF NOT EXISTS review_count   INT              NOT NULL default 0;
+
+CREATE OR REPLACE FUNCTION refresh_course_rating(p_course_id UUID) RETURNS void AS
+$$
+BEGIN
+    IF p_course_id IS NULL THEN
+        RETURN;
+    END IF;
+
+    UPDATE courses c
+    SET rating_average = r.average,
+        review_count   = r.count
+    FROM (SELECT COALESCE(avg(rating), 0) AS average, count(*) AS count
+          FROM reviews
+          WHERE course_id = p_course_id
+            AND state = 2
+            AND deleted_at IS NULL) r
+    WHERE c.id = p_course_id;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION reviews_refresh_course_rating() RETURNS trigger AS
+$$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        PERFORM refresh_course_rating(OLD.course_id);
+        RETURN NULL;
+    END IF;
+    PERFORM refresh_course_rating(NEW.course_id);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_reviews_refresh_course_rating ON reviews;
+CREATE TRIGGER trg_reviews_refresh_course_rating
+    AFTER INSERT OR DELETE OR UPDATE OF rating, state, deleted_at
+    ON reviews
+    FOR EACH ROW
+EXECUTE FUNCTION reviews_refresh_course_rating();
@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS idx_course_batches_unpublish_at;
+DROP INDEX IF EXISTS idx_course_batches_publish_at;
+DROP INDEX IF EXISTS idx_courses_unpublish_at;
+DROP INDEX IF EXISTS idx_courses_publish_at;
+ALTER TABLE course_batches
+    DROP CONSTRAINT IF EXISTS chk_course_batches_sales_window,
+    DROP COLUMN IF EXISTS publish_at,
+    DROP COLUMN IF EXISTS unpublish_at,
+    DROP COLUMN IF EXISTS sales_open_at,
+    DROP COLUMN IF EXISTS sales_close_at;
+ALTER TABLE courses
+    DROP COLUMN IF EXISTS publish_at,
+    DROP COLUMN IF EXISTS unpublish_at;
@@ -0,0 +1,21 @@
+-- publish_at and unpublish_at schedule the status changes of courses and
+-- batches. They are cleared by the scheduler once the status is changed.
+ALTER TABLE courses
+    ADD COLUMN IF NOT EXISTS publish_at   TIMESTAMP with time zone,
+    ADD COLUMN IF NOT EXISTS unpublish_at TIMESTAMP with time zone;
+
+-- sales_open_at and sales_close_at limit when seats of a batch can be
+-- reserved. Sales are open at any time when they are null.
+ALTER TABLE course_batches
+    ADD COLUMN IF NOT EXISTS publish_at     TIMESTAMP with time zone,
+    ADD COLUMN IF NOT EXISTS unpublish_at   TIMESTAMP with time zone,
+    ADD COLUMN IF NOT EXISTS sales_open_at  TIMESTAMP with time zone,
+    ADD COLUMN IF NOT EXISTS sales_close_at TIMESTAMP with time zone;
+
+ALTER TABLE course_batches
+    ADD CONSTRAINT chk_course_batches_sales_window CHECK (sales_close_at IS NULL OR sales_open_at IS NULL OR sales_open_at < sales_close_at);
+
+CREATE INDEX IF NOT EXISTS idx_courses_publish_at on courses (publish_at) WHERE publish_at IS NOT NULL AND deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_courses_unpublish_at on courses (unpublish_at) WHERE unpublish_at IS NOT NULL AND deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_course_batches_publish_at on course_batches (publish_at) WHERE publish_at IS NOT NULL AND deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_course_batches_unpublish_at on course_batches (unpublish_at) WHERE unpublish_at IS NOT NULL AND deleted_at IS NULL;
@@ -0,0 +1,63 @@
+DROP TRIGGER IF EXISTS trg_course_batches_refresh_course_prices_on_update ON course_batches;
+DROP TRIGGER IF EXISTS trg_course_batches_refresh_course_prices ON course_batches;
+
+ALTER TABLE course_prices
+    ADD COLUMN IF NOT EXISTS cheapest_available_batch_id UUID,
+    ADD COLUMN IF NOT EXISTS cheapest_available_price    DOUBLE PRECISION;
+
+CREATE OR REPLACE FUNCTION refresh_course_prices(p_course_id UUID) RETURNS void AS
+$$
+BEGIN
+    IF p_course_id IS NULL THEN
+        RETURN;
+    END IF;
+
+    PERFORM pg_advisory_xact_lock(hashtextextended('course_prices:' || p_course_id::text, 0));
+
+    DELETE
+    FROM course_prices cp
+    WHERE cp.course_id = p_course_id
+      AND NOT EXISTS (SELECT 1
+                      FROM course_batches cb
+                      WHERE cb.course_id = p_course_id
+                        AND cb.currency = cp.currency
+                        AND cb.price IS NOT NULL
+                        AND cb.status = 1
+                        AND cb.deleted_at IS NULL);
+
+    INSERT INTO course_prices (course_id, currency, min_price, max_price, cheapest_available_batch_id,
+                               cheapest_available_price, updated_at)
+    SELECT b.course_id,
+           b.currency,
+           min(b.price),
+           max(b.price),
+           (array_agg(b.id ORDER BY b.price, b.start_date NULLS LAST) FILTER (WHERE b.available))[1],
+           min(b.price) FILTER (WHERE b.available),
+           now()
+    FROM (SELECT cb.*,
+                 (cb.max_seats <= 0 OR cb.available_seats > 0) AND
+                 (cb.end_date IS NULL OR cb.end_date > now()) AS available
+          FROM course_batches cb
+          WHERE cb.course_id = p_course_id
+            AND cb.currency IS NOT NULL
+            AND cb.price IS NOT NULL
+            AND cb.status = 1
+            AND cb.deleted_at IS NULL) b
+    GROUP BY b.course_id, b.currency
+    ON CONFLICT (course_id, currency) DO UPDATE
+        SET min_price                   = EXCLUDED.min_price,
+            max_price                   = EXCLUDED.max_price,
+            cheapest_available_batch_id = EXCLUDED.cheapest_available_batch_id,
+            cheapest_available_price    = EXCLUDED.cheapest_available_price,
+            updated_at                  = EXCLUDED.updated_at;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_course_batches_refresh_course_prices
+    AFTER INSERT OR DELETE OR UPDATE OF course_id, price, currency, status, deleted_at, max_seats, available_seats, end_date
+    ON course_batches
+    FOR EACH ROW
+EXECUTE FUNCTION course_batches_refresh_course_prices();
+
+SELECT refresh_course_prices(id)
+FROM courses;
@@ -0,0 +1,70 @@
+-- the availability of the batches is computed when prices are read, so that
+-- reservations and ending batches do not refresh the summary.
+ALTER TABLE course_prices
+    DROP COLUMN IF EXISTS cheapest_available_batch_id,
+    DROP COLUMN IF EXISTS cheapest_available_price;
+
+CREATE OR REPLACE FUNCTION refresh_course_prices(p_course_id UUID) RETURNS void AS
+$$
+BEGIN
+    IF p_course_id IS NULL THEN
+        RETURN;
+    END IF;
+
+    -- serialize refreshes of the same course so that concurrent batch updates
+    -- do not overwrite each other with a stale summary.
+    PERFORM pg_advisory_xact_lock(hashtextextended('course_prices:' || p_course_id::text, 0));
+
+    DELETE
+    FROM course_prices cp
+    WHERE cp.course_id = p_course_id
+      AND NOT EXISTS (SELECT 1
+                      FROM course_batches cb
+                      WHERE cb.course_id = p_course_id
+                        AND cb.currency = cp.currency
+                        AND cb.price IS NOT NULL
+                        AND cb.status = 1
+                        AND cb.deleted_at IS NULL);
+
+    INSERT INTO course_prices (course_id, currency, min_price, max_price, updated_at)
+    SELECT cb.course_id,
+           cb.currency,
+           min(cb.price),
+           max(cb.price),
+           now()
+    FROM course_batches cb
+    WHERE cb.course_id = p_course_id
+      AND cb.currency IS NOT NULL
+      AND cb.price IS NOT NULL
+      AND cb.status = 1
+      AND cb.deleted_at IS NULL
+    GROUP BY cb.course_id, cb.currency
+    ON CONFLICT (course_id, currency) DO UPDATE
+        SET min_price  = EXCLUDED.min_price,
+            max_price  = EXCLUDED.max_price,
+            updated_at = EXCLUDED.updated_at;
+END;
+$$ LANGUAGE plpgsql;
+
+-- updates refresh the summary only when they change a column it is computed
+-- from, so that reserving seats does not take the lock of the course.
+DROP TRIGGER IF EXISTS trg_course_batches_refresh_course_prices ON course_batches;
+CREATE TRIGGER trg_course_batches_refresh_course_prices
+    AFTER INSERT OR DELETE
+    ON course_batches
+    FOR EACH ROW
+EXECUTE FUNCTION course_batches_refresh_course_prices();
+
+CREATE TRIGGER trg_course_batches_refresh_course_prices_on_update
+    AFTER UPDATE OF course_id, price, currency, status, deleted_at
+    ON course_batches
+    FOR EACH ROW
+    WHEN (OLD.course_id IS DISTINCT FROM NEW.course_id OR
+          OLD.price IS DISTINCT FROM NEW.price OR
+          OLD.currency IS DISTINCT FROM NEW.currency OR
+          OLD.status IS DISTINCT FROM NEW.status OR
+          OLD.deleted_at IS DISTINCT FROM NEW.deleted_at)
+EXECUTE FUNCTION course_batches_refresh_course_prices();
+
+SELECT refresh_course_prices(id)
+FROM courses;
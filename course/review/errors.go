@@ -0,0 +1,50 @@
+package review
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrInvalidRating      = ErrInvalidReview{Message: "review rating must be between 1 and 5"}
+	ErrTextTooLong        = ErrInvalidReview{Message: "review text must not be longer than 5000 characters"}
+	ErrAlreadyReviewed    = ErrReviewAlreadyExists{Message: "customer has already reviewed the course"}
+	ErrNoCompletedBooking = ErrNotAllowed{Message: "only customers with a completed booking of the course can review it"}
+	ErrNotAuthor          = ErrNotAllowed{Message: "only the author of the review can edit it"}
+)
+
+type ErrInvalidReview struct {
+	Message string
+}
+
+func (e ErrInvalidReview) Error() string {
+	return e.Message
+}
+
+func (e ErrInvalidReview) GRPCStatus() *status.Status {
+	return status.New(codes.InvalidArgument, e.Error())
+}
+
+type ErrReviewAlreadyExists struct {
+	Message string
+}
+
+func (e ErrReviewAlreadyExists) Error() string {
+	return e.Message
+}
+
+func (e ErrReviewAlreadyExists) GRPCStatus() *status.Status {
+	return status.New(codes.AlreadyExists, e.Error())
+}
+
+type ErrNotAllowed struct {
+	Message string
+}
+
+func (e ErrNotAllowed) Error() string {
+	return e.Message
+}
+
+func (e ErrNotAllowed) GRPCStatus() *status.Status {
+	return status.New(codes.PermissionDenied, e.Error())
+}
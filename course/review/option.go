@@ -0,0 +1,66 @@
+package review
+
+import "github.com/jmoiron/sqlx"
+
+type ListOptions struct {
+	Limit     uint64
+	PageToken string
+	State     State
+}
+
+type ListOption func(*ListOptions)
+
+func WithMaxResults(limit uint64) ListOption {
+	return func(o *ListOptions) {
+		if limit > 0 {
+			o.Limit = limit
+		}
+	}
+}
+
+// WithNextPage continues listing after the page the token was returned with.
+func WithNextPage(nextPage string) ListOption {
+	return func(o *ListOptions) {
+		o.PageToken = nextPage
+	}
+}
+
+// WithState only lists reviews in the state. Approved reviews are listed by default.
+func WithState(state State) ListOption {
+	return func(o *ListOptions) {
+		o.State = state
+	}
+}
+
+type FindOptions struct {
+	Tx        *sqlx.Tx
+	ForUpdate bool
+}
+
+type FindOption func(*FindOptions)
+
+func WithFindTx(tx *sqlx.Tx) FindOption {
+	return func(o *FindOptions) {
+		o.Tx = tx
+	}
+}
+
+// WithFindForUpdate locks the selected review until the transaction ends.
+// Only applicable together with WithFindTx.
+func WithFindForUpdate() FindOption {
+	return func(o *FindOptions) {
+		o.ForUpdate = true
+	}
+}
+
+type UpdateOptions struct {
+	Tx *sqlx.Tx
+}
+
+type UpdateOption func(*UpdateOptions)
+
+func WithUpdateTx(tx *sqlx.Tx) UpdateOption {
+	return func(o *UpdateOptions) {
+		o.Tx = tx
+	}
+}
@@ -0,0 +1,173 @@
+package review
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	v1 "github.com/imrenagicom/demo-app/pkg/apiclient/course/v1"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const maxTextLength = 5000
+
+type State int
+
+const (
+	StateUnknown State = iota
+	StatePending
+	StateApproved
+	StateRejected
+)
+
+func (s State) ApiV1() v1.ReviewState {
+	switch s {
+	case StatePending:
+		return v1.ReviewState_REVIEW_PENDING
+	case StateApproved:
+		return v1.ReviewState_REVIEW_APPROVED
+	case StateRejected:
+		return v1.ReviewState_REVIEW_REJECTED
+	default:
+		return v1.ReviewState_REVIEW_STATE_UNSPECIFIED
+	}
+}
+
+func StateFromApiV1(s v1.ReviewState) State {
+	switch s {
+	case v1.ReviewState_REVIEW_PENDING:
+		return StatePending
+	case v1.ReviewState_REVIEW_APPROVED:
+		return StateApproved
+	case v1.ReviewState_REVIEW_REJECTED:
+		return StateRejected
+	default:
+		return StateUnknown
+	}
+}
+
+func New(courseID, customerID uuid.UUID, rating int32, text string) *Review {
+	return &Review{
+		ID:         uuid.New(),
+		CourseID:   courseID,
+		CustomerID: customerID,
+		Rating:     rating,
+		Text:       strings.TrimSpace(text),
+		State:      StatePending,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}
+}
+
+type Review struct {
+	ID             uuid.UUID
+	CourseID       uuid.UUID
+	CustomerID     uuid.UUID
+	Rating         int32
+	Text           string
+	State          State
+	ModerationNote string
+	EditCount      int32
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	EditedAt       sql.NullTime
+	DeletedAt      sql.NullTime
+	Version        int64
+
+	// CourseSlug is the slug of the reviewed course. It is set by the service.
+	CourseSlug string
+}
+
+func (r *Review) Validate() error {
+	if r.Rating < 1 || r.Rating > 5 {
+		return ErrInvalidRating
+	}
+	if utf8.RuneCountInString(r.Text) > maxTextLength {
+		return ErrTextTooLong
+	}
+	return nil
+}
+
+// Edit replaces the fields listed in paths with the values from the given
+// review and returns the previous content. All editable fields are replaced
+// when paths is empty. An edited review goes back to moderation.
+func (r *Review) Edit(from *v1.Review, paths []string) (*Revision, error) {
+	rev := &Revision{
+		ReviewID:  r.ID,
+		Rating:    r.Rating,
+		Text:      r.Text,
+		CreatedAt: time.Now(),
+	}
+	if len(paths) == 0 {
+		paths = []string{"rating", "text"}
+	}
+	for _, p := range paths {
+		switch p {
+		case "rating":
+			r.Rating = from.GetRating()
+		case "text":
+			r.Text = strings.TrimSpace(from.GetText())
+		default:
+			return nil, ErrInvalidReview{Message: "field " + p + " can not be updated"}
+		}
+	}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	r.State = StatePending
+	r.ModerationNote = ""
+	r.EditCount++
+	r.UpdatedAt = rev.CreatedAt
+	r.EditedAt = sql.NullTime{Time: rev.CreatedAt, Valid: true}
+	return rev, nil
+}
+
+// Moderate approves or rejects the review.
+func (r *Review) Moderate(state State, note string) error {
+	if state != StateApproved && state != StateRejected {
+		return ErrInvalidReview{Message: "review can only be moderated to approved or rejected"}
+	}
+	r.State = state
+	r.ModerationNote = strings.TrimSpace(note)
+	r.UpdatedAt = time.Now()
+	return nil
+}
+
+func (r Review) ApiV1() *v1.Review {
+	var editedAt *timestamppb.Timestamp
+	if r.EditedAt.Valid {
+		editedAt = timestamppb.New(r.EditedAt.Time)
+	}
+	return &v1.Review{
+		ReviewId:       r.ID.String(),
+		Course:         r.CourseSlug,
+		Customer:       r.CustomerID.String(),
+		Rating:         r.Rating,
+		Text:           r.Text,
+		State:          r.State.ApiV1(),
+		ModerationNote: r.ModerationNote,
+		EditCount:      r.EditCount,
+		CreatedAt:      timestamppb.New(r.CreatedAt),
+		UpdatedAt:      timestamppb.New(r.UpdatedAt),
+		EditedAt:       editedAt,
+	}
+}
+
+// Revision is the content of a review before an edit.
+type Revision struct {
+	ReviewID  uuid.UUID
+	Rating    int32
+	Text      string
+	CreatedAt time.Time
+}
+
+func (r Revision) ApiV1() *v1.ReviewRevision {
+	return &v1.ReviewRevision{
+		Rating:    r.Rating,
+		Text:      r.Text,
+		CreatedAt: timestamppb.New(r.CreatedAt),
+	}
+}
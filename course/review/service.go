@@ -0,0 +1,213 @@
+package review
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/imrenagicom/demo-app/course/catalog"
+	"github.com/imrenagicom/demo-app/internal/auth"
+	"github.com/imrenagicom/demo-app/internal/db"
+	v1 "github.com/imrenagicom/demo-app/pkg/apiclient/course/v1"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+func NewService(db *sqlx.DB, store *Store, catalogStore *catalog.Store) *Service {
+	return &Service{
+		db:           db,
+		store:        store,
+		catalogStore: catalogStore,
+	}
+}
+
+type Service struct {
+	db           *sqlx.DB
+	store        *Store
+	catalogStore *catalog.Store
+}
+
+// CreateReview creates a pending review of the course by the customer of the
+// customer token. The customer must have a completed booking of the course.
+func (s Service) CreateReview(ctx context.Context, req *v1.CreateReviewRequest) (*Review, error) {
+	customerID, err := authenticatedCustomer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c, err := s.catalogStore.FindCourse(ctx, req.GetCourse())
+	if err != nil {
+		return nil, err
+	}
+
+	r := New(c.ID, customerID, req.GetReview().GetRating(), req.GetReview().GetText())
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	ok, err := s.store.HasCompletedBooking(ctx, c.ID, customerID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoCompletedBooking
+	}
+	if err := s.store.CreateReview(ctx, r); err != nil {
+		return nil, err
+	}
+	r.CourseSlug = c.Slug
+	return r, nil
+}
+
+func (s Service) GetReview(ctx context.Context, req *v1.GetReviewRequest) (*Review, error) {
+	c, err := s.catalogStore.FindCourse(ctx, req.GetCourse())
+	if err != nil {
+		return nil, err
+	}
+	r, err := s.findReview(ctx, c, req.GetReview())
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListReviews lists the approved reviews of the course. Only admins may list
+// the reviews of the other states.
+func (s Service) ListReviews(ctx context.Context, req *v1.ListReviewsRequest) ([]Review, string, error) {
+	state := StateFromApiV1(req.GetState())
+	if state != StateUnknown && state != StateApproved {
+		if err := auth.RequireAdmin(ctx); err != nil {
+			return nil, "", err
+		}
+	}
+	c, err := s.catalogStore.FindCourse(ctx, req.GetCourse())
+	if err != nil {
+		return nil, "", err
+	}
+	opts := []ListOption{
+		WithMaxResults(req.GetPageSize()),
+		WithNextPage(req.GetPageToken()),
+	}
+	if state != StateUnknown {
+		opts = append(opts, WithState(state))
+	}
+	reviews, nextPage, err := s.store.FindAllReviews(ctx, c.ID, opts...)
+	if err != nil {
+		return nil, "", err
+	}
+	for i := range reviews {
+		reviews[i].CourseSlug = c.Slug
+	}
+	return reviews, nextPage, nil
+}
+
+// UpdateReview edits the review of its author, the customer of the customer
+// token. The previous content is kept as a revision.
+func (s Service) UpdateReview(ctx context.Context, req *v1.UpdateReviewRequest) (*Review, error) {
+	customerID, err := authenticatedCustomer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c, err := s.catalogStore.FindCourse(ctx, req.GetCourse())
+	if err != nil {
+		return nil, err
+	}
+	if !isUUID(req.GetReview().GetReviewId()) {
+		return nil, db.ErrResourceNotFound{Message: fmt.Sprintf("review with id %s not found", req.GetReview().GetReviewId())}
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	r, err := s.store.FindReviewByID(ctx, c.ID, req.GetReview().GetReviewId(), WithFindTx(tx), WithFindForUpdate())
+	if err != nil {
+		return nil, err
+	}
+	if r.CustomerID != customerID {
+		return nil, ErrNotAuthor
+	}
+	rev, err := r.Edit(req.GetReview(), req.GetUpdateMask().GetPaths())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.store.CreateRevision(ctx, rev, WithUpdateTx(tx)); err != nil {
+		return nil, err
+	}
+	if err := s.store.UpdateReview(ctx, r, WithUpdateTx(tx)); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	r.CourseSlug = c.Slug
+	return r, nil
+}
+
+// ModerateReview approves or rejects the review. The admin token is required
+// by the interceptors.
+func (s Service) ModerateReview(ctx context.Context, req *v1.ModerateReviewRequest) (*Review, error) {
+	c, err := s.catalogStore.FindCourse(ctx, req.GetCourse())
+	if err != nil {
+		return nil, err
+	}
+	r, err := s.findReview(ctx, c, req.GetReview())
+	if err != nil {
+		return nil, err
+	}
+	if err := r.Moderate(StateFromApiV1(req.GetState()), req.GetModerationNote()); err != nil {
+		return nil, err
+	}
+	if err := s.store.UpdateReview(ctx, r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (s Service) ListReviewRevisions(ctx context.Context, req *v1.ListReviewRevisionsRequest) ([]Revision, error) {
+	c, err := s.catalogStore.FindCourse(ctx, req.GetCourse())
+	if err != nil {
+		return nil, err
+	}
+	r, err := s.findReview(ctx, c, req.GetReview())
+	if err != nil {
+		return nil, err
+	}
+	return s.store.FindRevisions(ctx, r.ID)
+}
+
+// findReview returns the review of the course. Reviews which are not
+// approved are only found by their author and by admins.
+func (s Service) findReview(ctx context.Context, c *catalog.Course, id string) (*Review, error) {
+	notFound := db.ErrResourceNotFound{Message: fmt.Sprintf("review with id %s not found", id)}
+	if !isUUID(id) {
+		return nil, notFound
+	}
+	r, err := s.store.FindReviewByID(ctx, c.ID, id)
+	if err != nil {
+		return nil, err
+	}
+	if r.State != StateApproved && auth.RequireCustomer(ctx, r.CustomerID.String()) != nil {
+		return nil, notFound
+	}
+	r.CourseSlug = c.Slug
+	return r, nil
+}
+
+// authenticatedCustomer returns the customer of the customer token of ctx.
+func authenticatedCustomer(ctx context.Context) (uuid.UUID, error) {
+	id, ok := auth.CustomerFromContext(ctx)
+	if !ok {
+		return uuid.Nil, auth.ErrUnauthenticated{Message: "customer token required"}
+	}
+	customerID, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, auth.ErrUnauthenticated{Message: "customer token is not of a valid customer id"}
+	}
+	return customerID, nil
+}
+
+func isUUID(s string) bool {
+	_, err := uuid.Parse(s)
+	return err == nil
+}
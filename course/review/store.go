@@ -0,0 +1,259 @@
+package review
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/imrenagicom/demo-app/course/booking"
+	"github.com/imrenagicom/demo-app/internal/db"
+	"github.com/imrenagicom/demo-app/internal/pagination"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/redis/go-redis/v9"
+)
+
+func NewStore(db *sqlx.DB, redis redis.UniversalClient) *Store {
+	return &Store{
+		db:      db,
+		dbCache: sq.NewStmtCache(db),
+		redis:   redis,
+	}
+}
+
+type Store struct {
+	db      *sqlx.DB
+	dbCache *sq.StmtCache
+	redis   redis.UniversalClient
+}
+
+func (s *Store) Clear() error {
+	return s.dbCache.Clear()
+}
+
+// reviewOrder lists the most recent reviews first.
+var reviewOrder = pagination.Order{Field: "created_at", Desc: true}
+
+var reviewColumns = []string{
+	"id", "course_id", "customer_id", "rating", "text", "state", "moderation_note", "edit_count",
+	"created_at", "updated_at", "edited_at", "version",
+}
+
+func scanReview(row sq.RowScanner) (*Review, error) {
+	var r Review
+	if err := row.Scan(&r.ID, &r.CourseID, &r.CustomerID, &r.Rating, &r.Text, &r.State, &r.ModerationNote, &r.EditCount,
+		&r.CreatedAt, &r.UpdatedAt, &r.EditedAt, &r.Version); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// HasCompletedBooking reports whether the customer has a completed booking of the course.
+func (s *Store) HasCompletedBooking(ctx context.Context, courseID, customerID uuid.UUID) (bool, error) {
+	sb := sq.StatementBuilder.RunWith(s.dbCache)
+	query := sb.Select("1").
+		Prefix("SELECT EXISTS (").
+		From("bookings").
+		Where(sq.Eq{
+			"course_id":   courseID,
+			"customer_id": customerID,
+			"status":      booking.StatusCompleted,
+			"deleted_at":  nil,
+		}).
+		Suffix(")").
+		PlaceholderFormat(sq.Dollar)
+
+	var exists bool
+	if err := query.QueryRowContext(ctx).Scan(&exists); err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+func (s *Store) CreateReview(ctx context.Context, r *Review) error {
+	sb := sq.StatementBuilder.RunWith(s.dbCache)
+	insertReview := sb.Insert("reviews").
+		Columns("id", "course_id", "customer_id", "rating", "text", "state", "created_at", "updated_at").
+		Values(r.ID, r.CourseID, r.CustomerID, r.Rating, r.Text, r.State, r.CreatedAt, r.UpdatedAt).
+		PlaceholderFormat(sq.Dollar)
+
+	_, err := insertReview.ExecContext(ctx)
+	if db.IsUniqueViolation(err) {
+		return ErrAlreadyReviewed
+	}
+	return err
+}
+
+func (s *Store) FindReviewByID(ctx context.Context, courseID uuid.UUID, id string, opts ...FindOption) (*Review, error) {
+	options := &FindOptions{}
+	for _, o := range opts {
+		o(options)
+	}
+
+	sb := sq.StatementBuilder.RunWith(s.dbCache)
+	if options.Tx != nil {
+		sb = sb.RunWith(options.Tx)
+	}
+	query := sb.Select(reviewColumns...).
+		From("reviews").
+		Where(sq.Eq{"id": id, "course_id": courseID, "deleted_at": nil}).
+		PlaceholderFormat(sq.Dollar)
+	if options.ForUpdate {
+		query = query.Suffix("FOR UPDATE")
+	}
+
+	r, err := scanReview(query.QueryRowContext(ctx))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, db.ErrResourceNotFound{Message: fmt.Sprintf("review with id %s not found", id)}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (s *Store) FindAllReviews(ctx context.Context, courseID uuid.UUID, opts ...ListOption) ([]Review, string, error) {
+	options := &ListOptions{
+		Limit: 10,
+		State: StateApproved,
+	}
+	for _, o := range opts {
+		o(options)
+	}
+
+	filter := pagination.Fingerprint("reviews", courseID, options.State)
+	cursor, err := pagination.Decode(options.PageToken, reviewOrder, filter)
+	if err != nil {
+		return nil, "", err
+	}
+
+	sb := sq.StatementBuilder.RunWith(s.dbCache)
+	query := sb.Select(reviewColumns...).
+		From("reviews").
+		Where(sq.Eq{"course_id": courseID, "state": options.State, "deleted_at": nil}).
+		OrderBy(reviewOrder.OrderBy("created_at", "id")...).
+		Limit(options.Limit + 1).
+		PlaceholderFormat(sq.Dollar)
+	if cursor != nil {
+		query = query.Where(reviewOrder.After("created_at", "id", cursor.Time, cursor.ID))
+	}
+
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var reviews []Review
+	for rows.Next() {
+		r, err := scanReview(rows)
+		if err != nil {
+			return nil, "", err
+		}
+		reviews = append(reviews, *r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	var nextPage string
+	if uint64(len(reviews)) > options.Limit {
+		reviews = reviews[:options.Limit]
+		last := reviews[len(reviews)-1]
+		nextPage = pagination.Encode(pagination.Cursor{
+			Order:  reviewOrder.String(),
+			Filter: filter,
+			Time:   last.CreatedAt,
+			ID:     last.ID,
+		})
+	}
+	return reviews, nextPage, nil
+}
+
+func (s *Store) UpdateReview(ctx context.Context, r *Review, opts ...UpdateOption) error {
+	options := &UpdateOptions{}
+	for _, o := range opts {
+		o(options)
+	}
+
+	sb := sq.StatementBuilder.RunWith(s.dbCache)
+	if options.Tx != nil {
+		sb = sb.RunWith(options.Tx)
+	}
+	updateReview := sb.Update("reviews").
+		Set("rating", r.Rating).
+		Set("text", r.Text).
+		Set("state", r.State).
+		Set("moderation_note", r.ModerationNote).
+		Set("edit_count", r.EditCount).
+		Set("updated_at", r.UpdatedAt).
+		Set("edited_at", r.EditedAt).
+		Set("deleted_at", r.DeletedAt).
+		Set("version", r.Version+1).
+		Where(sq.Eq{"id": r.ID, "version": r.Version}).
+		PlaceholderFormat(sq.Dollar)
+
+	res, err := updateReview.ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return db.ErrNoRowUpdated
+	}
+	r.Version++
+	return nil
+}
+
+func (s *Store) CreateRevision(ctx context.Context, rev *Revision, opts ...UpdateOption) error {
+	options := &UpdateOptions{}
+	for _, o := range opts {
+		o(options)
+	}
+
+	sb := sq.StatementBuilder.RunWith(s.dbCache)
+	if options.Tx != nil {
+		sb = sb.RunWith(options.Tx)
+	}
+	insertRevision := sb.Insert("review_revisions").
+		Columns("review_id", "rating", "text", "created_at").
+		Values(rev.ReviewID, rev.Rating, rev.Text, rev.CreatedAt).
+		PlaceholderFormat(sq.Dollar)
+
+	_, err := insertRevision.ExecContext(ctx)
+	return err
+}
+
+// FindRevisions returns the previous contents of the review, the oldest first.
+func (s *Store) FindRevisions(ctx context.Context, reviewID uuid.UUID) ([]Revision, error) {
+	sb := sq.StatementBuilder.RunWith(s.dbCache)
+	query := sb.Select("review_id", "rating", "text", "created_at").
+		From("review_revisions").
+		Where(sq.Eq{"review_id": reviewID}).
+		OrderBy("id").
+		PlaceholderFormat(sq.Dollar)
+
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var revisions []Revision
+	for rows.Next() {
+		var rev Revision
+		if err := rows.Scan(&rev.ReviewID, &rev.Rating, &rev.Text, &rev.CreatedAt); err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, rev)
+	}
+	return revisions, rows.Err()
+}
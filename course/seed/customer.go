@@ -0,0 +1,43 @@
+package seed
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/go-faker/faker/v4"
+	"github.com/google/uuid"
+)
+
+// seedCustomers is the number of customers the seeded bookings are made by.
+const seedCustomers = 500
+
+type seedCustomer struct {
+	id    uuid.UUID
+	name  string
+	email string
+}
+
+// seedCustomers creates the customers of the seeded bookings. Their emails
+// do not depend on the seed, so that customers seeded by an earlier run are
+// reused.
+func (s *Seeder) seedCustomers(ctx context.Context, r *rand.Rand) ([]seedCustomer, error) {
+	customers := make([]seedCustomer, seedCustomers)
+	for i := range customers {
+		c := seedCustomer{name: faker.Name(), email: fmt.Sprintf("customer%d@seed.example.com", i+1)}
+		err := sq.Insert("customers").
+			Columns("id", "name", "email").
+			Values(newID(r), c.name, c.email).
+			Suffix("ON CONFLICT (email) DO UPDATE SET email = EXCLUDED.email RETURNING id, name").
+			PlaceholderFormat(sq.Dollar).
+			RunWith(s.db).
+			QueryRowContext(ctx).
+			Scan(&c.id, &c.name)
+		if err != nil {
+			return nil, fmt.Errorf("create customer %s: %w", c.email, err)
+		}
+		customers[i] = c
+	}
+	return customers, nil
+}
@@ -0,0 +1,144 @@
+package seed
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+)
+
+// SeatDistribution decides how many seats of a batch are already sold.
+type SeatDistribution string
+
+const (
+	// SeatsUniform sells between none and all seats of a batch.
+	SeatsUniform SeatDistribution = "uniform"
+	// SeatsEmpty sells no seats.
+	SeatsEmpty SeatDistribution = "empty"
+	// SeatsSoldOut sells all seats.
+	SeatsSoldOut SeatDistribution = "sold-out"
+	// SeatsMostlySoldOut sells out 80% of the batches and almost all seats of the others.
+	SeatsMostlySoldOut SeatDistribution = "mostly-sold-out"
+)
+
+var seatDistributions = []SeatDistribution{SeatsUniform, SeatsEmpty, SeatsSoldOut, SeatsMostlySoldOut}
+
+func ParseSeatDistribution(s string) (SeatDistribution, error) {
+	for _, d := range seatDistributions {
+		if string(d) == s {
+			return d, nil
+		}
+	}
+	return "", fmt.Errorf("unknown seat distribution %q, expected one of %s", s, joinNames(seatDistributions))
+}
+
+// sold returns the number of sold seats of a batch having max seats.
+func (d SeatDistribution) sold(r *rand.Rand, max int32) int32 {
+	if max <= 0 {
+		return 0
+	}
+	switch d {
+	case SeatsEmpty:
+		return 0
+	case SeatsSoldOut:
+		return max
+	case SeatsMostlySoldOut:
+		if r.Float64() < 0.8 {
+			return max
+		}
+		left := 1 + r.Int31n(max/10+1)
+		if left > max {
+			left = max
+		}
+		return max - left
+	default:
+		return r.Int31n(max + 1)
+	}
+}
+
+// Profile is a named set of options for a scenario.
+type Profile struct {
+	Name        string
+	Description string
+	Options     Options
+}
+
+// DefaultProfile is the catalog seeded when no profile is given.
+const DefaultProfile = "default"
+
+var profiles = map[string]Profile{
+	DefaultProfile: {
+		Name:        DefaultProfile,
+		Description: "1000 upcoming courses with 1-5 batches of 50 seats, partially sold",
+		Options:     defaultOptions(),
+	},
+	"sold-out-heavy": {
+		Name:        "sold-out-heavy",
+		Description: "like default, but most batches are sold out",
+		Options: with(defaultOptions(), func(o *Options) {
+			o.Seats = SeatsMostlySoldOut
+		}),
+	},
+	"single-hot-batch": {
+		Name:        "single-hot-batch",
+		Description: "a single course with a single empty batch of 10000 seats, for contention tests",
+		Options: with(defaultOptions(), func(o *Options) {
+			o.Courses = 1
+			o.MinBatches = 1
+			o.MaxBatches = 1
+			o.MaxSeats = 10000
+			o.Seats = SeatsEmpty
+		}),
+	},
+	"past-batches": {
+		Name:        "past-batches",
+		Description: "like default, but 80% of the batches have already ended",
+		Options: with(defaultOptions(), func(o *Options) {
+			o.PastRatio = 0.8
+		}),
+	},
+}
+
+func defaultOptions() Options {
+	return Options{
+		Courses:    1000,
+		MinBatches: 1,
+		MaxBatches: 5,
+		MaxSeats:   50,
+		Seats:      SeatsUniform,
+		Currencies: []string{"IDR"},
+	}
+}
+
+func with(o Options, fn func(o *Options)) Options {
+	fn(&o)
+	return o
+}
+
+// FindProfile returns the profile with the given name.
+func FindProfile(name string) (Profile, error) {
+	p, ok := profiles[name]
+	if !ok {
+		return Profile{}, fmt.Errorf("unknown profile %q, expected one of %s", name, strings.Join(ProfileNames(), ", "))
+	}
+	p.Options.Currencies = append([]string{}, p.Options.Currencies...)
+	return p, nil
+}
+
+// ProfileNames returns the sorted names of all profiles.
+func ProfileNames() []string {
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func joinNames(ds []SeatDistribution) string {
+	names := make([]string, len(ds))
+	for i, d := range ds {
+		names[i] = string(d)
+	}
+	return strings.Join(names, ", ")
+}
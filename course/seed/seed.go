@@ -0,0 +1,286 @@
+// Package seed fills the catalog with generated courses, batches and the
+// bookings of their sold seats, made by seeded customers.
+package seed
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/imrenagicom/demo-app/course/booking"
+	"github.com/imrenagicom/demo-app/course/catalog"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/go-faker/faker/v4"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// bookingsPerInsert keeps the number of parameters of a single insert below
+// the limit of postgres.
+const bookingsPerInsert = 1000
+
+type Options struct {
+	// Courses is the number of courses to create.
+	Courses int
+	// MinBatches and MaxBatches bound the number of batches per course.
+	MinBatches int
+	MaxBatches int
+	// MaxSeats is the number of seats of every batch.
+	MaxSeats int32
+	// Seats decides how many seats of every batch are sold.
+	Seats SeatDistribution
+	// Currencies are picked at random for every batch.
+	Currencies []string
+	// PastRatio is the ratio of batches which have already ended.
+	PastRatio float64
+	// Seed of the random generator. A random seed is used when it is zero.
+	Seed int64
+	// Truncate deletes all courses, together with their batches, bookings
+	// and reviews, before seeding.
+	Truncate bool
+}
+
+func (o Options) Validate() error {
+	switch {
+	case o.Courses < 0:
+		return errors.New("courses must not be negative")
+	case o.MinBatches < 0 || o.MaxBatches < o.MinBatches:
+		return errors.New("batches must be between min and max batches")
+	case o.MaxSeats < 0:
+		return errors.New("max seats must not be negative")
+	case o.PastRatio < 0 || o.PastRatio > 1:
+		return errors.New("past ratio must be between 0 and 1")
+	case len(o.Currencies) == 0:
+		return errors.New("at least one currency is required")
+	}
+	if _, err := ParseSeatDistribution(string(o.Seats)); err != nil {
+		return err
+	}
+	return nil
+}
+
+type Report struct {
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+	Seed       int64     `json:"seed"`
+	Truncated  bool      `json:"truncated"`
+	Courses    int       `json:"courses"`
+	Batches    int       `json:"batches"`
+	Bookings   int       `json:"bookings"`
+}
+
+func NewSeeder(db *sqlx.DB, catalogStore *catalog.Store) *Seeder {
+	return &Seeder{
+		db:           db,
+		catalogStore: catalogStore,
+	}
+}
+
+// Seeder creates courses with their batches. The available seats of every
+// batch match its completed bookings, so seeded data has no inventory drift.
+// Runs with the same options and seed create the same catalog, with dates
+// relative to the day of the run.
+type Seeder struct {
+	db           *sqlx.DB
+	catalogStore *catalog.Store
+}
+
+func (s *Seeder) Run(ctx context.Context, opts Options) (*Report, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if opts.Seed == 0 {
+		opts.Seed = time.Now().UnixNano()
+	}
+	r := rand.New(rand.NewSource(opts.Seed))
+	faker.SetRandomSource(faker.NewSafeSource(rand.NewSource(opts.Seed)))
+
+	report := &Report{
+		StartedAt: time.Now(),
+		Seed:      opts.Seed,
+		Truncated: opts.Truncate,
+	}
+	log.Ctx(ctx).Info().Int64("seed", opts.Seed).Msg("seeding catalog")
+
+	if opts.Truncate {
+		if _, err := s.db.ExecContext(ctx, "TRUNCATE courses CASCADE"); err != nil {
+			return nil, err
+		}
+	}
+
+	leaves, err := s.seedTaxonomy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	customers, err := s.seedCustomers(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	// every seeded date is derived from today, the only input which is not
+	// derived from the seed.
+	today := time.Now().Truncate(24 * time.Hour)
+	for i := 0; i < opts.Courses; i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		c := newCourse(r, today)
+		numBatches := opts.MinBatches + r.Intn(opts.MaxBatches-opts.MinBatches+1)
+		sold := make([]int32, numBatches)
+		for j := 0; j < numBatches; j++ {
+			b := newBatch(r, today, opts)
+			sold[j] = opts.Seats.sold(r, b.MaxSeats)
+			b.AvailableSeats = b.MaxSeats - sold[j]
+			c.Batches = append(c.Batches, b)
+			if b.CreatedAt.Before(c.CreatedAt) {
+				c.CreatedAt = b.CreatedAt
+			}
+		}
+
+		if err := s.catalogStore.CreateCourse(ctx, c); err != nil {
+			return nil, fmt.Errorf("create course %s: %w", c.Slug, err)
+		}
+		for j := range c.Batches {
+			n, err := s.createBookings(ctx, r, today, c, &c.Batches[j], sold[j], customers)
+			if err != nil {
+				return nil, err
+			}
+			report.Bookings += n
+		}
+
+		leaf := leaves[r.Intn(len(leaves))]
+		if err := s.catalogStore.SetCourseCategories(ctx, c.ID, []uuid.UUID{leaf.categoryID}); err != nil {
+			return nil, err
+		}
+		tags := append([]string{}, leaf.tags...)
+		r.Shuffle(len(tags), func(i, j int) { tags[i], tags[j] = tags[j], tags[i] })
+		if _, err := s.catalogStore.SetCourseTags(ctx, c.ID, tags[:r.Intn(4)+1]); err != nil {
+			return nil, err
+		}
+
+		report.Courses++
+		report.Batches += numBatches
+	}
+	report.FinishedAt = time.Now()
+	return report, nil
+}
+
+func newCourse(r *rand.Rand, today time.Time) *catalog.Course {
+	publishedAt := today.AddDate(0, 0, -r.Intn(365))
+	return &catalog.Course{
+		ID:          newID(r),
+		CreatedAt:   publishedAt,
+		UpdatedAt:   publishedAt,
+		Name:        faker.Name(),
+		Slug:        strings.ToLower(faker.Username()),
+		Description: faker.Paragraph(),
+		PublishedAt: sql.NullTime{Time: publishedAt, Valid: true},
+		Status:      catalog.CourseStatusPublished,
+	}
+}
+
+func newBatch(r *rand.Rand, today time.Time, opts Options) catalog.Batch {
+	var start time.Time
+	if r.Float64() < opts.PastRatio {
+		start = today.AddDate(0, -2, -1-r.Intn(180))
+	} else {
+		start = today.AddDate(0, 0, r.Intn(90))
+	}
+	// batches are created one to three months before they start, and never
+	// after the day of the seeding.
+	createdAt := start.AddDate(0, 0, -30-r.Intn(60))
+	if createdAt.After(today) {
+		createdAt = today
+	}
+	currency := opts.Currencies[r.Intn(len(opts.Currencies))]
+	return catalog.Batch{
+		ID:        newID(r),
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+		Name:      faker.Name(),
+		MaxSeats:  opts.MaxSeats,
+		Status:    catalog.BatchStatusPublished,
+		Price:     price(r, currency),
+		Currency:  currency,
+		StartDate: sql.NullTime{Time: start, Valid: true},
+		EndDate:   sql.NullTime{Time: start.AddDate(0, 2, 0), Valid: true},
+	}
+}
+
+// priceRanges are the minimum and maximum prices per currency. Other
+// currencies use defaultPriceRange.
+var (
+	priceRanges = map[string][2]int{
+		"IDR": {100000, 200000},
+		"USD": {10, 200},
+		"EUR": {10, 200},
+		"SGD": {15, 300},
+	}
+	defaultPriceRange = [2]int{10, 1000}
+)
+
+func price(r *rand.Rand, currency string) float64 {
+	pr, ok := priceRanges[strings.ToUpper(currency)]
+	if !ok {
+		pr = defaultPriceRange
+	}
+	return float64(pr[0] + r.Intn(pr[1]-pr[0]))
+}
+
+// createBookings creates a completed and invoiced booking of a random customer
+// for each of the sold seats of the batch. The seats are booked after the
+// batch is created, and before it starts and before the day of the seeding.
+func (s *Seeder) createBookings(ctx context.Context, r *rand.Rand, today time.Time, c *catalog.Course, b *catalog.Batch, sold int32, customers []seedCustomer) (int, error) {
+	sales := b.StartDate.Time
+	if today.Before(sales) {
+		sales = today
+	}
+	// leaves an hour to pay before sales end.
+	window := int64(sales.Add(-time.Hour).Sub(b.CreatedAt))
+	created := 0
+	for created < int(sold) {
+		insert := sq.Insert("bookings").
+			Columns("id", "course_id", "course_batch_id", "price", "currency", "status",
+				"reserved_at", "paid_at", "created_at", "updated_at",
+				"customer_id", "cust_name", "cust_email", "invoice_number").
+			PlaceholderFormat(sq.Dollar)
+		n := 0
+		for ; n < bookingsPerInsert && created+n < int(sold); n++ {
+			bookedAt := b.CreatedAt
+			if window > 0 {
+				bookedAt = bookedAt.Add(time.Duration(r.Int63n(window)))
+			}
+			paidAt := bookedAt.Add(time.Duration(r.Intn(60)) * time.Minute)
+			id := newID(r)
+			cust := customers[r.Intn(len(customers))]
+			insert = insert.Values(id, c.ID, b.ID, b.Price, b.Currency, booking.StatusCompleted,
+				bookedAt, paidAt, bookedAt, paidAt,
+				cust.id, cust.name, cust.email, invoiceNumber(id, paidAt))
+		}
+		if _, err := insert.RunWith(s.db).ExecContext(ctx); err != nil {
+			return created, fmt.Errorf("create bookings of batch %s: %w", b.ID, err)
+		}
+		created += n
+	}
+	return created, nil
+}
+
+// invoiceNumber returns the invoice number of the seeded booking id paid at paidAt.
+func invoiceNumber(id uuid.UUID, paidAt time.Time) string {
+	return fmt.Sprintf("INV-%s-%s", paidAt.UTC().Format("20060102"), strings.ToUpper(id.String()[:8]))
+}
+
+func newID(r *rand.Rand) uuid.UUID {
+	id, err := uuid.NewRandomFromReader(r)
+	if err != nil {
+		return uuid.New()
+	}
+	return id
+}
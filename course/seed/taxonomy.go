@@ -0,0 +1,48 @@
+package seed
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/imrenagicom/demo-app/course/catalog"
+
+	"github.com/google/uuid"
+)
+
+// categories is the category tree created by the Seeder, keyed by the top level categories.
+var categories = []struct {
+	name     string
+	children []string
+	tags     []string
+}{
+	{"Software Development", []string{"Web Development", "Mobile Development", "Programming Languages"}, []string{"JavaScript", "Go", "Python", "React", "Kotlin", "Flutter"}},
+	{"Data Science", []string{"Machine Learning", "Data Analysis"}, []string{"Python", "SQL", "Statistics", "Deep Learning", "Pandas"}},
+	{"Business", []string{"Entrepreneurship", "Marketing"}, []string{"Startup", "SEO", "Sales", "Product Management"}},
+	{"Design", []string{"UX Design", "Graphic Design"}, []string{"Figma", "User Research", "Typography", "Illustration"}},
+	{"IT & Operations", []string{"Cloud Computing", "DevOps", "Security"}, []string{"AWS", "Kubernetes", "Docker", "Terraform", "Linux"}},
+}
+
+type leaf struct {
+	categoryID uuid.UUID
+	tags       []string
+}
+
+// seedTaxonomy creates the seed categories and returns their leaves.
+func (s *Seeder) seedTaxonomy(ctx context.Context) ([]leaf, error) {
+	var leaves []leaf
+	for _, top := range categories {
+		parent := catalog.NewCategory(top.name, fmt.Sprintf("Courses about %s.", strings.ToLower(top.name)), nil)
+		if err := s.catalogStore.EnsureCategory(ctx, parent); err != nil {
+			return nil, err
+		}
+		for _, name := range top.children {
+			child := catalog.NewCategory(name, fmt.Sprintf("Courses about %s.", strings.ToLower(name)), parent)
+			if err := s.catalogStore.EnsureCategory(ctx, child); err != nil {
+				return nil, err
+			}
+			leaves = append(leaves, leaf{categoryID: child.ID, tags: top.tags})
+		}
+	}
+	return leaves, nil
+}
@@ -0,0 +1,238 @@
+package apiserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	demoapp "github.com/imrenagicom/demo-app"
+	"github.com/imrenagicom/demo-app/course/inventory"
+	"github.com/imrenagicom/demo-app/internal/audit"
+	"github.com/imrenagicom/demo-app/internal/auth"
+	"github.com/imrenagicom/demo-app/internal/config"
+	"github.com/imrenagicom/demo-app/internal/instrumentation"
+
+	"github.com/gorilla/mux"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// adminActorHeader names the person using the admin API in the audit trail.
+const adminActorHeader = "X-Actor"
+
+const defaultAdminActor = "admin"
+
+// maxAdminBodySize bounds the request bodies of the admin API.
+const maxAdminBodySize = 1 << 20
+
+// registerAdmin serves the admin API under /admin. Requests need the bearer
+// token of the admin config, and every change is audit-logged. Changes of
+// the log level and the faults last until a config reload changes the log
+// level or the faults of the file.
+func (s *Server) registerAdmin(r *mux.Router) {
+	admin := r.PathPrefix("/admin").Subrouter()
+	admin.Use(s.adminAuth)
+	admin.HandleFunc("/buildinfo", s.adminBuildInfo).Methods(http.MethodGet)
+	admin.HandleFunc("/log-level", s.adminGetLogLevel).Methods(http.MethodGet)
+	admin.HandleFunc("/log-level", s.adminSetLogLevel).Methods(http.MethodPut)
+	admin.HandleFunc("/chaos", s.adminGetChaos).Methods(http.MethodGet)
+	admin.HandleFunc("/chaos", s.adminSetChaos).Methods(http.MethodPut)
+	admin.HandleFunc("/cache", s.adminGetCache).Methods(http.MethodGet)
+	admin.HandleFunc("/cache", s.adminSetCache).Methods(http.MethodPut)
+	admin.HandleFunc("/cache/flush", s.adminFlushCache).Methods(http.MethodPost)
+	admin.HandleFunc("/reconcile", s.adminReconcile).Methods(http.MethodPost)
+}
+
+// adminAuth rejects requests without the admin token and sets the actor of
+// the audit trail from the X-Actor header.
+func (s *Server) adminAuth(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p, err := s.authn.Authenticate(r.Header.Get("Authorization"))
+		if err != nil || !p.Admin {
+			log.Warn().Str("path", r.URL.Path).Str("remote_addr", r.RemoteAddr).Msg("unauthorized admin request")
+			w.Header().Set("WWW-Authenticate", `Bearer realm="admin"`)
+			writeAdminError(w, http.StatusUnauthorized, errors.New("missing or invalid admin token"))
+			return
+		}
+		actor := r.Header.Get(adminActorHeader)
+		if actor == "" {
+			actor = defaultAdminActor
+		}
+		ctx := auth.WithPrincipal(r.Context(), p)
+		next.ServeHTTP(w, r.WithContext(audit.WithActor(ctx, actor)))
+	})
+}
+
+// auditAdmin records a change done through the admin API. The change is
+// already applied, so a failure to record it is only logged.
+func (s *Server) auditAdmin(ctx context.Context, action, resource string, details map[string]any) {
+	entry := audit.NewEntry(ctx, action, resource, details)
+	log.Info().Str("actor", entry.Actor).Str("action", action).Str("resource", resource).
+		Interface("details", details).Msg("admin change")
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+	if err := s.auditStore.Record(ctx, entry); err != nil {
+		log.Error().Err(err).Str("action", action).Msg("unable to record admin change in the audit trail")
+	}
+}
+
+func (s *Server) adminBuildInfo(w http.ResponseWriter, r *http.Request) {
+	writeAdminJSON(w, http.StatusOK, demoapp.GetVersion())
+}
+
+type logLevel struct {
+	Level string `json:"level"`
+}
+
+func (s *Server) adminGetLogLevel(w http.ResponseWriter, r *http.Request) {
+	writeAdminJSON(w, http.StatusOK, logLevel{Level: zerolog.GlobalLevel().String()})
+}
+
+func (s *Server) adminSetLogLevel(w http.ResponseWriter, r *http.Request) {
+	var req logLevel
+	if err := readAdminJSON(w, r, &req); err != nil {
+		writeAdminError(w, http.StatusBadRequest, err)
+		return
+	}
+	// the level is checked before it is applied, as an empty level parses
+	// as zerolog.NoLevel.
+	if _, err := zerolog.ParseLevel(req.Level); err != nil || req.Level == "" {
+		writeAdminError(w, http.StatusBadRequest, errors.New("level must be one of trace, debug, info, warn, error, fatal or panic"))
+		return
+	}
+	old := zerolog.GlobalLevel().String()
+	if err := instrumentation.SetLogLevel(req.Level); err != nil {
+		writeAdminError(w, http.StatusInternalServerError, err)
+		return
+	}
+	s.auditAdmin(r.Context(), "admin.log_level.update", "admin/log-level", map[string]any{"old": old, "new": req.Level})
+	writeAdminJSON(w, http.StatusOK, logLevel{Level: zerolog.GlobalLevel().String()})
+}
+
+func (s *Server) adminGetChaos(w http.ResponseWriter, r *http.Request) {
+	writeAdminJSON(w, http.StatusOK, s.chaos.Config())
+}
+
+func (s *Server) adminSetChaos(w http.ResponseWriter, r *http.Request) {
+	var req config.Chaos
+	if err := readAdminJSON(w, r, &req); err != nil {
+		writeAdminError(w, http.StatusBadRequest, err)
+		return
+	}
+	if err := req.Validate(); err != nil {
+		writeAdminError(w, http.StatusBadRequest, err)
+		return
+	}
+	old := s.chaos.Config()
+	s.chaos.Configure(req)
+	warnUnmatchedFaults(req)
+	s.auditAdmin(r.Context(), "admin.chaos.update", "admin/chaos", map[string]any{"old": old, "new": req})
+	writeAdminJSON(w, http.StatusOK, s.chaos.Config())
+}
+
+type cacheState struct {
+	Enabled bool `json:"enabled"`
+}
+
+func (s *Server) adminGetCache(w http.ResponseWriter, r *http.Request) {
+	writeAdminJSON(w, http.StatusOK, cacheState{Enabled: s.catalogStore.CacheEnabled()})
+}
+
+func (s *Server) adminSetCache(w http.ResponseWriter, r *http.Request) {
+	var req cacheState
+	if err := readAdminJSON(w, r, &req); err != nil {
+		writeAdminError(w, http.StatusBadRequest, err)
+		return
+	}
+	old := s.catalogStore.CacheEnabled()
+	s.catalogStore.SetCacheEnabled(req.Enabled)
+	s.auditAdmin(r.Context(), "admin.cache.update", "admin/cache", map[string]any{"old": old, "new": req.Enabled})
+	writeAdminJSON(w, http.StatusOK, cacheState{Enabled: s.catalogStore.CacheEnabled()})
+}
+
+func (s *Server) adminFlushCache(w http.ResponseWriter, r *http.Request) {
+	deleted, err := s.catalogStore.FlushCache(r.Context())
+	s.auditAdmin(r.Context(), "admin.cache.flush", "admin/cache", map[string]any{"deleted": deleted, "error": errString(err)})
+	if err != nil {
+		writeAdminError(w, http.StatusServiceUnavailable, err)
+		return
+	}
+	writeAdminJSON(w, http.StatusOK, map[string]int{"deleted": deleted})
+}
+
+// adminReconcile reconciles the seat inventory. The query parameters fix and
+// dry_run are those of the reconcile command.
+func (s *Server) adminReconcile(w http.ResponseWriter, r *http.Request) {
+	var opts []inventory.Option
+	details := map[string]any{}
+	for _, param := range []struct {
+		name   string
+		option inventory.Option
+	}{
+		{"fix", inventory.WithFix()},
+		{"dry_run", inventory.WithDryRun()},
+	} {
+		value := r.URL.Query().Get(param.name)
+		if value == "" {
+			continue
+		}
+		set, err := strconv.ParseBool(value)
+		if err != nil {
+			writeAdminError(w, http.StatusBadRequest, errors.New(param.name+" must be a boolean"))
+			return
+		}
+		details[param.name] = set
+		if set {
+			opts = append(opts, param.option)
+		}
+	}
+	if batches := r.URL.Query()["batch"]; len(batches) > 0 {
+		details["batches"] = batches
+		opts = append(opts, inventory.WithBatches(batches...))
+	}
+
+	report, err := s.reconciler.Reconcile(r.Context(), opts...)
+	if err != nil {
+		details["error"] = err.Error()
+	} else {
+		details["drifts"] = len(report.Drifts)
+	}
+	s.auditAdmin(r.Context(), "admin.reconcile", "admin/reconcile", details)
+	if err != nil {
+		writeAdminError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeAdminJSON(w, http.StatusOK, report)
+}
+
+func readAdminJSON(w http.ResponseWriter, r *http.Request, v any) error {
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxAdminBodySize))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return errors.New("invalid request body: " + err.Error())
+	}
+	return nil
+}
+
+func writeAdminJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.Encode(v)
+}
+
+func writeAdminError(w http.ResponseWriter, status int, err error) {
+	writeAdminJSON(w, status, map[string]string{"error": err.Error()})
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
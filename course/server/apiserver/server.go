@@ -5,22 +5,42 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"reflect"
 	"time"
 
 	_ "net/http/pprof"
 
 	"github.com/imrenagicom/demo-app/course/booking"
 	"github.com/imrenagicom/demo-app/course/catalog"
+	"github.com/imrenagicom/demo-app/course/customer"
+	"github.com/imrenagicom/demo-app/course/inventory"
+	"github.com/imrenagicom/demo-app/course/review"
 	bookingsrv "github.com/imrenagicom/demo-app/course/server/booking"
 	catalogsrv "github.com/imrenagicom/demo-app/course/server/catalog"
+	customersrv "github.com/imrenagicom/demo-app/course/server/customer"
+	instructorsrv "github.com/imrenagicom/demo-app/course/server/instructor"
+	reviewsrv "github.com/imrenagicom/demo-app/course/server/review"
+	"github.com/imrenagicom/demo-app/internal/audit"
+	"github.com/imrenagicom/demo-app/internal/auth"
+	"github.com/imrenagicom/demo-app/internal/certs"
+	"github.com/imrenagicom/demo-app/internal/chaos"
 	"github.com/imrenagicom/demo-app/internal/config"
+	"github.com/imrenagicom/demo-app/internal/db"
+	grpcutil "github.com/imrenagicom/demo-app/internal/grpc"
+	"github.com/imrenagicom/demo-app/internal/instrumentation"
+	"github.com/imrenagicom/demo-app/internal/pagination"
+	"github.com/imrenagicom/demo-app/internal/postgres"
+	"github.com/imrenagicom/demo-app/internal/ratelimit"
 	"github.com/imrenagicom/demo-app/internal/util"
 	v1 "github.com/imrenagicom/demo-app/pkg/apiclient/course/v1"
 
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/rs/zerolog/log"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
@@ -29,26 +49,92 @@ var serviceTelemetryName = "course-service"
 type ServerOpts struct {
 	Clients *util.Clients
 	Config  config.Server
+	// ConfigProvider serves the reloadable keys of Config. When it is nil,
+	// Config never changes.
+	ConfigProvider *config.Provider
 }
 
 func NewServer(opts ServerOpts) Server {
 	log.Debug().
 		Str("postgres", fmt.Sprintf("%s:%s/%s", opts.Config.DB.Host, opts.Config.DB.Port, opts.Config.DB.Name)).
 		Str("redis", opts.Config.Redis.Addr()).
+		Str("reservation_strategy", opts.Config.Booking.ReservationStrategy).
 		Msg("checking config")
 
+	if opts.ConfigProvider == nil {
+		opts.ConfigProvider = config.NewProvider(opts.Config, nil)
+	}
 	s := Server{
 		opts:    opts,
 		clients: opts.Clients,
+		config:  opts.ConfigProvider,
+	}
+
+	s.authn = auth.NewAuthenticator(opts.Config.Admin.Token, opts.Config.Auth.CustomerTokenSecret)
+	s.chaos = chaos.New(opts.ConfigProvider.Get().Chaos)
+	if s.chaos.Config().Enabled {
+		log.Warn().Int("faults", len(s.chaos.Config().Faults)).Msg("chaos is enabled, injecting faults")
+	}
+	warnUnmatchedFaults(s.chaos.Config())
+
+	rateLimit := opts.ConfigProvider.Get().RateLimit
+	s.limiter = ratelimit.NewLimiter(rateLimit.RequestsPerSec, rateLimit.Burst)
+	// reloads only apply the log level and the faults changed in the file,
+	// so that the changes of the admin api are not reverted by reloads of
+	// other keys. Reloads are serialized by the provider.
+	last := opts.ConfigProvider.Get()
+	opts.ConfigProvider.OnReload(func(c config.Server) {
+		if c.Log.Level != last.Log.Level {
+			if err := instrumentation.SetLogLevel(c.Log.Level); err != nil {
+				log.Error().Err(err).Msg("unable to change log level")
+			}
+		}
+		s.limiter.SetLimit(c.RateLimit.RequestsPerSec, c.RateLimit.Burst)
+		if !reflect.DeepEqual(last.Chaos, c.Chaos) {
+			s.chaos.Configure(c.Chaos)
+			warnUnmatchedFaults(c.Chaos)
+		}
+		last = c
+	})
+
+	if opts.Config.Pagination.CursorSecret != "" {
+		pagination.SetSecret(opts.Config.Pagination.CursorSecret)
+	} else {
+		log.Warn().Msg("pagination cursor secret is not set, page tokens are only valid until restart")
 	}
 
-	s.catalogStore = catalog.NewStore(opts.Clients.DB, opts.Clients.Redis)
+	storeOpts := []catalog.StoreOption{catalog.WithChaos(s.chaos)}
+	if len(opts.Config.DB.Replicas) > 0 {
+		router, err := postgres.NewRouter(opts.Config.DB)
+		if err != nil {
+			log.Fatal().Err(err).Msg("invalid replica config")
+		}
+		s.replicas = router
+		storeOpts = append(storeOpts, catalog.WithReplicas(router))
+	}
+	s.catalogStore = catalog.NewStore(opts.Clients.DB, opts.Clients.Redis, storeOpts...)
 	s.catalogService = catalog.NewService(s.catalogStore, opts.Clients.DB)
-	s.bookingStore = booking.NewStore(opts.Clients.DB, opts.Clients.Redis)
+	s.customerStore = customer.NewStore(opts.Clients.DB, opts.Clients.Redis)
+	s.auditStore = audit.NewStore(opts.Clients.DB)
+	s.customerService = customer.NewService(opts.Clients.DB, s.customerStore, s.auditStore)
+	s.reconciler = inventory.NewReconciler(opts.Clients.DB, inventory.WithSeatCache(s.catalogStore))
+	s.scheduler = catalog.NewScheduler(s.catalogStore)
+	s.reviewStore = review.NewStore(opts.Clients.DB, opts.Clients.Redis)
+	s.reviewService = review.NewService(opts.Clients.DB, s.reviewStore, s.catalogStore)
+	s.bookingStore = booking.NewStore(opts.Clients.DB, opts.Clients.Redis, booking.WithStoreChaos(s.chaos))
+	strategy, err := booking.ParseReservationStrategy(opts.Config.Booking.ReservationStrategy)
+	if err != nil {
+		log.Fatal().Err(err).Msg("invalid booking config")
+	}
 	s.bookingService = booking.NewService(
 		opts.Clients.DB,
 		s.bookingStore,
 		s.catalogStore,
+		s.customerService,
+		booking.WithReservationStrategy(strategy),
+		booking.WithLimits(s.bookingLimits),
+		booking.WithTransactionRetry(opts.Config.DB.TransactionRetry.Policy()),
+		booking.WithChaos(s.chaos),
 	)
 	return s
 }
@@ -57,11 +143,24 @@ type Server struct {
 	opts                 ServerOpts
 	clients              *util.Clients
 	otlpCollectorAddress string
+	config               *config.Provider
+	limiter              *ratelimit.Limiter
+	authn                *auth.Authenticator
+	chaos                *chaos.Injector
+	// replicas routes the catalog reads, it is nil without replicas.
+	replicas *postgres.Router
 
-	bookingService *booking.Service
-	bookingStore   *booking.Store
-	catalogService *catalog.Service
-	catalogStore   *catalog.Store
+	bookingService  *booking.Service
+	bookingStore    *booking.Store
+	catalogService  *catalog.Service
+	catalogStore    *catalog.Store
+	customerService *customer.Service
+	customerStore   *customer.Store
+	auditStore      *audit.Store
+	reconciler      *inventory.Reconciler
+	scheduler       *catalog.Scheduler
+	reviewService   *review.Service
+	reviewStore     *review.Store
 }
 
 // Run runs the gRPC-Gateway, dialing the provided address.
@@ -69,22 +168,54 @@ func (s *Server) Run(ctx context.Context) error {
 	log.Info().Msg("starting server")
 
 	grpcServer := s.newGRPCServer(ctx)
-	go func() {
-		log.Info().Msgf("initializing grpc server on %s", s.opts.Config.GRPC.Addr())
-		lis, err := net.Listen("tcp", s.opts.Config.GRPC.Addr())
-		if err != nil {
-			log.Fatal().Msgf("failed to listen: %v", err)
-		}
-		log.Info().Msgf("starting grpc server on %s", s.opts.Config.GRPC.Addr())
-		if err := grpcServer.Serve(lis); err != nil {
-			log.Fatal().Err(err).Msg("unable to start grpc server")
+	if s.opts.Config.SinglePort {
+		log.Info().Msgf("serving grpc and http on the single port %s", s.opts.Config.HTTP.Addr())
+	} else {
+		go func() {
+			log.Info().Msgf("initializing grpc server on %s", s.opts.Config.GRPC.Addr())
+			lis, err := net.Listen("tcp", s.opts.Config.GRPC.Addr())
+			if err != nil {
+				log.Fatal().Msgf("failed to listen: %v", err)
+			}
+			log.Info().Msgf("starting grpc server on %s", s.opts.Config.GRPC.Addr())
+			if err := grpcServer.Serve(lis); err != nil {
+				log.Fatal().Err(err).Msg("unable to start grpc server")
+			}
+		}()
+	}
+
+	if s.opts.Config.Reconcile.Enabled {
+		interval := time.Duration(s.opts.Config.Reconcile.IntervalSec) * time.Second
+		var opts []inventory.Option
+		if s.opts.Config.Reconcile.Fix {
+			opts = append(opts, inventory.WithFix())
 		}
-	}()
+		log.Info().Msgf("starting seat inventory reconciliation every %s", interval)
+		go s.reconciler.Run(ctx, interval, opts...)
+	}
+
+	if s.replicas != nil {
+		interval := time.Duration(s.opts.Config.DB.ReplicaCheckIntervalSec) * time.Second
+		log.Info().Msgf("routing catalog reads to %d replicas, checking their health every %s", len(s.opts.Config.DB.Replicas), interval)
+		go s.replicas.Run(ctx, interval)
+	}
+
+	if s.opts.Config.Scheduler.Enabled {
+		interval := time.Duration(s.opts.Config.Scheduler.IntervalSec) * time.Second
+		log.Info().Msgf("starting publish scheduler every %s", interval)
+		go s.scheduler.Run(ctx, interval)
+	}
 
-	httpServer := s.newHTTPServer(ctx)
+	httpServer := s.newHTTPServer(ctx, grpcServer)
 	go func() {
 		log.Info().Msgf("Starting http server for serving gRPC-Gateway and OpenAPI Documentation on %s", s.opts.Config.HTTP.Addr())
-		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		var err error
+		if httpServer.TLSConfig != nil {
+			err = httpServer.ListenAndServeTLS("", "")
+		} else {
+			err = httpServer.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
 			log.Fatal().Err(err).Msgf("listen:%+s\n", err)
 		}
 	}()
@@ -112,51 +243,218 @@ func (s *Server) Run(ctx context.Context) error {
 	if err := s.bookingStore.Clear(); err != nil {
 		log.Warn().Err(err).Msg("failed to clear concert store")
 	}
+	if err := s.customerStore.Clear(); err != nil {
+		log.Warn().Err(err).Msg("failed to clear customer store")
+	}
+	if err := s.auditStore.Clear(); err != nil {
+		log.Warn().Err(err).Msg("failed to clear audit store")
+	}
+	if err := s.reviewStore.Clear(); err != nil {
+		log.Warn().Err(err).Msg("failed to clear review store")
+	}
+	if s.replicas != nil {
+		if err := s.replicas.Close(); err != nil {
+			log.Warn().Err(err).Msg("failed to close replicas")
+		}
+	}
 	return nil
 }
 
+// bookingLimits returns the booking limits of the current config.
+func (s Server) bookingLimits() booking.Limits {
+	c := s.config.Get().Booking
+	return booking.Limits{
+		HoldDuration:          time.Duration(c.HoldDurationSec) * time.Second,
+		MaxReservationRetries: c.MaxReservationRetries,
+		MaxReleaseRetries:     c.MaxReleaseRetries,
+	}
+}
+
+func (s *Server) rateLimitEnabled() bool {
+	return s.config.Get().RateLimit.Enabled
+}
+
+// adminMethods are the methods only the holders of the admin token may call.
+var adminMethods = map[string]bool{
+	v1.CustomerService_EraseCustomer_FullMethodName:         true,
+	v1.ReviewService_ModerateReview_FullMethodName:          true,
+	v1.CatalogService_CreateCategory_FullMethodName:         true,
+	v1.CatalogService_AssignCourseCategories_FullMethodName: true,
+	v1.CatalogService_AssignCourseTags_FullMethodName:       true,
+	v1.CatalogService_ScheduleCourse_FullMethodName:         true,
+	v1.CatalogService_ScheduleBatch_FullMethodName:          true,
+	v1.CatalogService_ImportCourses_FullMethodName:          true,
+	v1.CatalogService_ExportCourses_FullMethodName:          true,
+	v1.InstructorService_CreateInstructor_FullMethodName:    true,
+	v1.InstructorService_UpdateInstructor_FullMethodName:    true,
+	v1.InstructorService_DeleteInstructor_FullMethodName:    true,
+	v1.InstructorService_AssignInstructor_FullMethodName:    true,
+	v1.InstructorService_UnassignInstructor_FullMethodName:  true,
+}
+
+// grpcMethods are the full methods of the gRPC services.
+var grpcMethods = grpcutil.FullMethods(
+	v1.BookingService_ServiceDesc,
+	v1.CatalogService_ServiceDesc,
+	v1.CustomerService_ServiceDesc,
+	v1.InstructorService_ServiceDesc,
+	v1.ReviewService_ServiceDesc,
+)
+
+// warnUnmatchedFaults logs the gRPC fault targets of c which match no method.
+func warnUnmatchedFaults(c config.Chaos) {
+	for _, target := range grpcutil.UnmatchedFaultTargets(c, grpcMethods) {
+		log.Warn().Str("target", target).Msg("chaos fault target matches no grpc method, the fault is never injected")
+	}
+}
+
+// unaryInterceptors are the interceptors of the unary methods, also run
+// around the in-process gateway in single port mode.
+func (s *Server) unaryInterceptors() []grpc.UnaryServerInterceptor {
+	return []grpc.UnaryServerInterceptor{
+		grpcutil.UnaryServerRateLimitInterceptor(s.limiter, s.rateLimitEnabled),
+		grpcutil.UnaryServerAuthInterceptor(s.authn, adminMethods),
+		grpcutil.UnaryServerReplicaInterceptor(),
+		grpcutil.UnaryServerRetryInterceptor(s.opts.Config.DB.ReadRetry.Policy(), db.IsTransient),
+		grpcutil.UnaryServerChaosInterceptor(s.chaos),
+	}
+}
+
 func (s *Server) newGRPCServer(ctx context.Context) *grpc.Server {
-	opts := []grpc.ServerOption{}
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(s.unaryInterceptors()...),
+		grpc.ChainStreamInterceptor(
+			grpcutil.StreamServerRateLimitInterceptor(s.limiter, s.rateLimitEnabled),
+			grpcutil.StreamServerAuthInterceptor(s.authn, adminMethods),
+			grpcutil.StreamServerReplicaInterceptor(),
+			grpcutil.StreamServerChaosInterceptor(s.chaos),
+		),
+	}
+	// in single port mode TLS is terminated by the http server.
+	if s.opts.Config.GRPC.TLS.Enabled && !s.opts.Config.SinglePort {
+		tlsConfig, err := certs.ServerConfig(s.opts.Config.GRPC.TLS)
+		if err != nil {
+			log.Fatal().Err(err).Msg("invalid grpc tls config")
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
 	grpcServer := grpc.NewServer(opts...)
-	bookingSrv := bookingsrv.New(s.bookingService)
-	catalogSrv := catalogsrv.New(s.catalogService)
-	v1.RegisterBookingServiceServer(grpcServer, bookingSrv)
-	v1.RegisterCatalogServiceServer(grpcServer, catalogSrv)
+	srv := s.newAPIServers()
+	v1.RegisterBookingServiceServer(grpcServer, srv.booking)
+	v1.RegisterCatalogServiceServer(grpcServer, srv.catalog)
+	v1.RegisterCustomerServiceServer(grpcServer, srv.customer)
+	v1.RegisterInstructorServiceServer(grpcServer, srv.instructor)
+	v1.RegisterReviewServiceServer(grpcServer, srv.review)
 	return grpcServer
 }
 
-func (s *Server) newHTTPServer(ctx context.Context) *http.Server {
+// apiServers are the implementations of the gRPC services.
+type apiServers struct {
+	booking    v1.BookingServiceServer
+	catalog    v1.CatalogServiceServer
+	customer   v1.CustomerServiceServer
+	instructor v1.InstructorServiceServer
+	review     v1.ReviewServiceServer
+}
+
+func (s *Server) newAPIServers() apiServers {
+	return apiServers{
+		booking:    bookingsrv.New(s.bookingService),
+		catalog:    catalogsrv.New(s.catalogService),
+		customer:   customersrv.New(s.customerService),
+		instructor: instructorsrv.New(s.catalogService),
+		review:     reviewsrv.New(s.reviewService),
+	}
+}
+
+// newGateway returns the gateway proxying to the gRPC server over a connection.
+func (s *Server) newGateway(ctx context.Context) *runtime.ServeMux {
 	gRPCEndpoint := s.opts.Config.GRPC.Addr()
+	creds := insecure.NewCredentials()
+	if s.opts.Config.GRPC.TLS.Enabled {
+		tlsConfig, err := certs.ClientConfig(s.opts.Config.GRPC.TLS)
+		if err != nil {
+			log.Fatal().Err(err).Msg("invalid grpc tls config")
+		}
+		creds = credentials.NewTLS(tlsConfig)
+	}
 	conn, err := grpc.DialContext(
 		ctx,
 		gRPCEndpoint,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(creds),
 	)
 	if err != nil {
 		log.Fatal().Err(err).Msgf("failed to dial grpc server: %v", err)
 	}
 
-	gwmux := runtime.NewServeMux()
+	gwmux := newGatewayMux()
 	mustRegisterGWHandler(ctx, v1.RegisterCatalogServiceHandler, gwmux, conn)
 	mustRegisterGWHandler(ctx, v1.RegisterBookingServiceHandler, gwmux, conn)
+	mustRegisterGWHandler(ctx, v1.RegisterCustomerServiceHandler, gwmux, conn)
+	mustRegisterGWHandler(ctx, v1.RegisterInstructorServiceHandler, gwmux, conn)
+	mustRegisterGWHandler(ctx, v1.RegisterReviewServiceHandler, gwmux, conn)
+	return gwmux
+}
+
+func newGatewayMux() *runtime.ServeMux {
+	return runtime.NewServeMux(
+		runtime.WithForwardResponseOption(grpcutil.ForwardRedirect("/api/course/v1")),
+		runtime.WithErrorHandler(grpcutil.GatewayErrorHandler),
+		runtime.WithIncomingHeaderMatcher(grpcutil.GatewayHeaderMatcher),
+	)
+}
+
+func (s *Server) newHTTPServer(ctx context.Context, grpcServer *grpc.Server) *http.Server {
+	single := s.opts.Config.SinglePort
+	var gwmux *runtime.ServeMux
+	if single {
+		gwmux = s.newInProcessGateway(ctx)
+	} else {
+		gwmux = s.newGateway(ctx)
+	}
 
 	mux := mux.NewRouter()
 	mux.HandleFunc("/healthz", s.healthz())
 	mux.HandleFunc("/readyz", s.readyz())
+	if s.opts.Config.Admin.Enabled {
+		s.registerAdmin(mux)
+	}
 
 	mux.PathPrefix("/debug/").Handler(http.DefaultServeMux)
 
 	api := mux.PathPrefix("/api/course").Subrouter()
-	api.Use() // TODO add required middleware for /api here
+	if single {
+		// the in-process gateway skips the interceptors of the gRPC server.
+		methods, err := grpcutil.NewMethodResolver(grpcServer.GetServiceInfo())
+		if err != nil {
+			log.Fatal().Err(err).Msg("unable to read the http rules of the services")
+		}
+		api.Use(grpcutil.InterceptHTTP(gwmux, methods, s.unaryInterceptors()...))
+	}
 	api.PathPrefix("/v1").Handler(gwmux)
 
 	sh := http.StripPrefix("/swagger/",
 		http.FileServer(http.Dir("./third_party/OpenAPI/")))
 	mux.PathPrefix("/swagger/").Handler(sh)
 
+	var handler http.Handler = mux
+	if single {
+		handler = grpcHandler(grpcServer, mux)
+		if !s.opts.Config.HTTP.TLS.Enabled {
+			handler = h2c.NewHandler(handler, &http2.Server{})
+		}
+	}
+
 	gwServer := &http.Server{
 		Addr:    s.opts.Config.HTTP.Addr(),
-		Handler: mux,
+		Handler: handler,
+	}
+	if s.opts.Config.HTTP.TLS.Enabled {
+		tlsConfig, err := certs.ServerConfig(s.opts.Config.HTTP.TLS)
+		if err != nil {
+			log.Fatal().Err(err).Msg("invalid http tls config")
+		}
+		gwServer.TLSConfig = tlsConfig
 	}
 	return gwServer
 }
@@ -0,0 +1,47 @@
+package apiserver
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	v1 "github.com/imrenagicom/demo-app/pkg/apiclient/course/v1"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+)
+
+type registerServerFunc[T any] func(ctx context.Context, mux *runtime.ServeMux, server T) error
+
+// mustRegisterGWServer is mustRegisterGWHandler for in-process handlers.
+func mustRegisterGWServer[T any](ctx context.Context, register registerServerFunc[T], mux *runtime.ServeMux, server T) {
+	if err := register(ctx, mux, server); err != nil {
+		panic(err)
+	}
+}
+
+// newInProcessGateway returns the gateway calling the services directly
+// instead of through a gRPC connection. Streaming methods, such as the
+// catalog import and export, are not supported by the in-process gateway and
+// are only served over gRPC.
+func (s *Server) newInProcessGateway(ctx context.Context) *runtime.ServeMux {
+	srv := s.newAPIServers()
+	gwmux := newGatewayMux()
+	mustRegisterGWServer(ctx, v1.RegisterCatalogServiceHandlerServer, gwmux, srv.catalog)
+	mustRegisterGWServer(ctx, v1.RegisterBookingServiceHandlerServer, gwmux, srv.booking)
+	mustRegisterGWServer(ctx, v1.RegisterCustomerServiceHandlerServer, gwmux, srv.customer)
+	mustRegisterGWServer(ctx, v1.RegisterInstructorServiceHandlerServer, gwmux, srv.instructor)
+	mustRegisterGWServer(ctx, v1.RegisterReviewServiceHandlerServer, gwmux, srv.review)
+	return gwmux
+}
+
+// grpcHandler sends gRPC requests to grpcServer and all other requests to next.
+func grpcHandler(grpcServer *grpc.Server, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ProtoMajor == 2 && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+			grpcServer.ServeHTTP(w, r)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
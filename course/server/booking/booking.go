@@ -60,7 +60,7 @@ func (s Server) ExpireBooking(ctx context.Context, req *v1.ExpireBookingRequest)
 }
 
 func (s Server) ListBookings(ctx context.Context, req *v1.ListBookingsRequest) (*v1.ListBookingsResponse, error) {
-	bookings, _, err := s.service.ListBookings(ctx, req)
+	bookings, nextPage, err := s.service.ListBookings(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -69,6 +69,7 @@ func (s Server) ListBookings(ctx context.Context, req *v1.ListBookingsRequest) (
 		bks = append(bks, b.ApiV1())
 	}
 	return &v1.ListBookingsResponse{
-		Bookings: bks,
+		Bookings:      bks,
+		NextPageToken: nextPage,
 	}, nil
 }
@@ -2,14 +2,31 @@ package catalog
 
 import (
 	"context"
+	"fmt"
+	"io"
 
 	"github.com/imrenagicom/demo-app/course/catalog"
+	grpcutil "github.com/imrenagicom/demo-app/internal/grpc"
 	v1 "github.com/imrenagicom/demo-app/pkg/apiclient/course/v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 type Service interface {
 	ListCourse(ctx context.Context, req *v1.ListCoursesRequest) ([]catalog.Course, string, error)
 	GetCourse(ctx context.Context, req *v1.GetCourseRequest) (*catalog.Course, error)
+	GetBatch(ctx context.Context, req *v1.GetBatchRequest) (*catalog.Batch, error)
+	ListBatches(ctx context.Context, req *v1.ListBatchesRequest) ([]catalog.Batch, string, error)
+	SearchCourses(ctx context.Context, req *v1.SearchCoursesRequest) (*catalog.SearchResult, error)
+	ListCategories(ctx context.Context, req *v1.ListCategoriesRequest) ([]catalog.Category, error)
+	CreateCategory(ctx context.Context, req *v1.CreateCategoryRequest) (*catalog.Category, error)
+	ListTags(ctx context.Context, req *v1.ListTagsRequest) ([]catalog.Tag, error)
+	AssignCourseCategories(ctx context.Context, req *v1.AssignCourseCategoriesRequest) (*catalog.Course, error)
+	AssignCourseTags(ctx context.Context, req *v1.AssignCourseTagsRequest) (*catalog.Course, error)
+	ScheduleCourse(ctx context.Context, req *v1.ScheduleCourseRequest) error
+	ImportCourses(ctx context.Context, r catalog.RecordReader, opts ...catalog.ImportOption) (*catalog.ImportReport, error)
+	ExportCourses(ctx context.Context, req *v1.ExportCoursesRequest, fn func(rec *v1.CourseRecord) error) error
+	ScheduleBatch(ctx context.Context, req *v1.ScheduleBatchRequest) error
 }
 
 func New(s Service) *Server {
@@ -47,5 +64,167 @@ func (s Server) GetCourse(ctx context.Context, req *v1.GetCourseRequest) (*v1.Co
 	if err != nil {
 		return nil, err
 	}
+	if course.Renamed(req.GetCourse()) {
+		if err := grpcutil.SetRedirect(ctx, fmt.Sprintf("courses/%s", course.Slug)); err != nil {
+			return nil, err
+		}
+	}
 	return course.ApiV1(), nil
 }
+
+func (s Server) ListBatches(ctx context.Context, req *v1.ListBatchesRequest) (*v1.ListBatchesResponse, error) {
+	batches, nextPage, err := s.service.ListBatches(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var data []*v1.Batch
+	for _, b := range batches {
+		data = append(data, b.ApiV1())
+	}
+	return &v1.ListBatchesResponse{
+		Batches:       data,
+		NextPageToken: nextPage,
+	}, nil
+}
+
+func (s Server) GetBatch(ctx context.Context, req *v1.GetBatchRequest) (*v1.Batch, error) {
+	batch, err := s.service.GetBatch(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if batch.Renamed(req.GetCourse(), req.GetBatch()) {
+		if err := grpcutil.SetRedirect(ctx, fmt.Sprintf("courses/%s/batches/%s", batch.CourseSlug, batch.Slug)); err != nil {
+			return nil, err
+		}
+	}
+	return batch.ApiV1(), nil
+}
+
+func (s Server) SearchCourses(ctx context.Context, req *v1.SearchCoursesRequest) (*v1.SearchCoursesResponse, error) {
+	res, err := s.service.SearchCourses(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return res.ApiV1(), nil
+}
+
+func (s Server) ListCategories(ctx context.Context, req *v1.ListCategoriesRequest) (*v1.ListCategoriesResponse, error) {
+	categories, err := s.service.ListCategories(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var data []*v1.Category
+	for _, c := range categories {
+		data = append(data, c.ApiV1())
+	}
+	return &v1.ListCategoriesResponse{Categories: data}, nil
+}
+
+func (s Server) CreateCategory(ctx context.Context, req *v1.CreateCategoryRequest) (*v1.Category, error) {
+	category, err := s.service.CreateCategory(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return category.ApiV1(), nil
+}
+
+func (s Server) ListTags(ctx context.Context, req *v1.ListTagsRequest) (*v1.ListTagsResponse, error) {
+	tags, err := s.service.ListTags(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var data []*v1.Tag
+	for _, t := range tags {
+		data = append(data, t.ApiV1())
+	}
+	return &v1.ListTagsResponse{Tags: data}, nil
+}
+
+func (s Server) AssignCourseCategories(ctx context.Context, req *v1.AssignCourseCategoriesRequest) (*v1.Course, error) {
+	course, err := s.service.AssignCourseCategories(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return course.ApiV1(), nil
+}
+
+func (s Server) AssignCourseTags(ctx context.Context, req *v1.AssignCourseTagsRequest) (*v1.Course, error) {
+	course, err := s.service.AssignCourseTags(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return course.ApiV1(), nil
+}
+
+func (s Server) ScheduleCourse(ctx context.Context, req *v1.ScheduleCourseRequest) (*emptypb.Empty, error) {
+	if err := s.service.ScheduleCourse(ctx, req); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s Server) ScheduleBatch(ctx context.Context, req *v1.ScheduleBatchRequest) (*emptypb.Empty, error) {
+	if err := s.service.ScheduleBatch(ctx, req); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s Server) ImportCourses(stream v1.CatalogService_ImportCoursesServer) error {
+	r := &importStreamReader{stream: stream}
+	// options are only accepted as the first message.
+	first, err := stream.Recv()
+	if err != nil && err != io.EOF {
+		return err
+	}
+	var opts []catalog.ImportOption
+	if o := first.GetOptions(); o != nil {
+		if o.GetDryRun() {
+			opts = append(opts, catalog.WithImportDryRun())
+		}
+		opts = append(opts, catalog.WithImportChunkSize(int(o.GetChunkSize())))
+	} else {
+		r.first = first
+	}
+
+	report, err := s.service.ImportCourses(stream.Context(), r, opts...)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(report.ApiV1())
+}
+
+// importStreamReader reads the records of an import from a client stream.
+type importStreamReader struct {
+	stream v1.CatalogService_ImportCoursesServer
+	first  *v1.ImportCoursesRequest
+	row    int64
+}
+
+func (r *importStreamReader) Read() (*v1.CourseRecord, int64, error) {
+	for {
+		req := r.first
+		r.first = nil
+		if req == nil {
+			var err error
+			if req, err = r.stream.Recv(); err != nil {
+				return nil, r.row, err
+			}
+		}
+		if req.GetPayload() == nil {
+			continue
+		}
+		r.row++
+		if req.GetOptions() != nil {
+			return nil, r.row, catalog.RowError{Row: r.row, Message: "options are only accepted as the first message"}
+		}
+		return req.GetRecord(), r.row, nil
+	}
+}
+
+func (s Server) ExportCourses(req *v1.ExportCoursesRequest, stream v1.CatalogService_ExportCoursesServer) error {
+	return s.service.ExportCourses(stream.Context(), req, stream.Send)
+}
@@ -0,0 +1,59 @@
+package customer
+
+import (
+	"context"
+
+	"github.com/imrenagicom/demo-app/course/customer"
+	v1 "github.com/imrenagicom/demo-app/pkg/apiclient/course/v1"
+)
+
+type Service interface {
+	CreateCustomer(ctx context.Context, req *v1.CreateCustomerRequest) (*customer.Customer, error)
+	GetCustomer(ctx context.Context, req *v1.GetCustomerRequest) (*customer.Customer, error)
+	UpdateCustomer(ctx context.Context, req *v1.UpdateCustomerRequest) (*customer.Customer, error)
+	EraseCustomer(ctx context.Context, req *v1.EraseCustomerRequest) (int64, error)
+}
+
+func New(s Service) *Server {
+	return &Server{
+		service: s,
+	}
+}
+
+type Server struct {
+	v1.UnimplementedCustomerServiceServer
+
+	service Service
+}
+
+func (s Server) CreateCustomer(ctx context.Context, req *v1.CreateCustomerRequest) (*v1.Customer, error) {
+	c, err := s.service.CreateCustomer(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return c.ApiV1(), nil
+}
+
+func (s Server) GetCustomer(ctx context.Context, req *v1.GetCustomerRequest) (*v1.Customer, error) {
+	c, err := s.service.GetCustomer(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return c.ApiV1(), nil
+}
+
+func (s Server) UpdateCustomer(ctx context.Context, req *v1.UpdateCustomerRequest) (*v1.Customer, error) {
+	c, err := s.service.UpdateCustomer(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return c.ApiV1(), nil
+}
+
+func (s Server) EraseCustomer(ctx context.Context, req *v1.EraseCustomerRequest) (*v1.EraseCustomerResponse, error) {
+	n, err := s.service.EraseCustomer(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.EraseCustomerResponse{AnonymizedBookings: n}, nil
+}
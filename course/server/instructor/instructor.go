@@ -0,0 +1,94 @@
+package instructor
+
+import (
+	"context"
+
+	"github.com/imrenagicom/demo-app/course/catalog"
+	v1 "github.com/imrenagicom/demo-app/pkg/apiclient/course/v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type Service interface {
+	CreateInstructor(ctx context.Context, req *v1.CreateInstructorRequest) (*catalog.Instructor, error)
+	GetInstructor(ctx context.Context, req *v1.GetInstructorRequest) (*catalog.Instructor, error)
+	ListInstructors(ctx context.Context, req *v1.ListInstructorsRequest) ([]catalog.Instructor, string, error)
+	UpdateInstructor(ctx context.Context, req *v1.UpdateInstructorRequest) (*catalog.Instructor, error)
+	DeleteInstructor(ctx context.Context, req *v1.DeleteInstructorRequest) error
+	AssignInstructor(ctx context.Context, req *v1.AssignInstructorRequest) (*catalog.Instructor, error)
+	UnassignInstructor(ctx context.Context, req *v1.UnassignInstructorRequest) error
+}
+
+func New(s Service) *Server {
+	return &Server{
+		service: s,
+	}
+}
+
+type Server struct {
+	v1.UnimplementedInstructorServiceServer
+
+	service Service
+}
+
+func (s Server) CreateInstructor(ctx context.Context, req *v1.CreateInstructorRequest) (*v1.Instructor, error) {
+	i, err := s.service.CreateInstructor(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return i.ApiV1(), nil
+}
+
+func (s Server) GetInstructor(ctx context.Context, req *v1.GetInstructorRequest) (*v1.Instructor, error) {
+	i, err := s.service.GetInstructor(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return i.ApiV1(), nil
+}
+
+func (s Server) ListInstructors(ctx context.Context, req *v1.ListInstructorsRequest) (*v1.ListInstructorsResponse, error) {
+	instructors, nextPage, err := s.service.ListInstructors(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var data []*v1.Instructor
+	for _, i := range instructors {
+		data = append(data, i.ApiV1())
+	}
+	return &v1.ListInstructorsResponse{
+		Instructors:   data,
+		NextPageToken: nextPage,
+	}, nil
+}
+
+func (s Server) UpdateInstructor(ctx context.Context, req *v1.UpdateInstructorRequest) (*v1.Instructor, error) {
+	i, err := s.service.UpdateInstructor(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return i.ApiV1(), nil
+}
+
+func (s Server) DeleteInstructor(ctx context.Context, req *v1.DeleteInstructorRequest) (*emptypb.Empty, error) {
+	if err := s.service.DeleteInstructor(ctx, req); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s Server) AssignInstructor(ctx context.Context, req *v1.AssignInstructorRequest) (*v1.Instructor, error) {
+	i, err := s.service.AssignInstructor(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return i.ApiV1(), nil
+}
+
+func (s Server) UnassignInstructor(ctx context.Context, req *v1.UnassignInstructorRequest) (*emptypb.Empty, error) {
+	if err := s.service.UnassignInstructor(ctx, req); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,90 @@
+package review
+
+import (
+	"context"
+
+	"github.com/imrenagicom/demo-app/course/review"
+	v1 "github.com/imrenagicom/demo-app/pkg/apiclient/course/v1"
+)
+
+type Service interface {
+	CreateReview(ctx context.Context, req *v1.CreateReviewRequest) (*review.Review, error)
+	GetReview(ctx context.Context, req *v1.GetReviewRequest) (*review.Review, error)
+	ListReviews(ctx context.Context, req *v1.ListReviewsRequest) ([]review.Review, string, error)
+	UpdateReview(ctx context.Context, req *v1.UpdateReviewRequest) (*review.Review, error)
+	ModerateReview(ctx context.Context, req *v1.ModerateReviewRequest) (*review.Review, error)
+	ListReviewRevisions(ctx context.Context, req *v1.ListReviewRevisionsRequest) ([]review.Revision, error)
+}
+
+func New(s Service) *Server {
+	return &Server{
+		service: s,
+	}
+}
+
+type Server struct {
+	v1.UnimplementedReviewServiceServer
+
+	service Service
+}
+
+func (s Server) CreateReview(ctx context.Context, req *v1.CreateReviewRequest) (*v1.Review, error) {
+	r, err := s.service.CreateReview(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return r.ApiV1(), nil
+}
+
+func (s Server) GetReview(ctx context.Context, req *v1.GetReviewRequest) (*v1.Review, error) {
+	r, err := s.service.GetReview(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return r.ApiV1(), nil
+}
+
+func (s Server) ListReviews(ctx context.Context, req *v1.ListReviewsRequest) (*v1.ListReviewsResponse, error) {
+	reviews, nextPage, err := s.service.ListReviews(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var data []*v1.Review
+	for _, r := range reviews {
+		data = append(data, r.ApiV1())
+	}
+	return &v1.ListReviewsResponse{
+		Reviews:       data,
+		NextPageToken: nextPage,
+	}, nil
+}
+
+func (s Server) UpdateReview(ctx context.Context, req *v1.UpdateReviewRequest) (*v1.Review, error) {
+	r, err := s.service.UpdateReview(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return r.ApiV1(), nil
+}
+
+func (s Server) ModerateReview(ctx context.Context, req *v1.ModerateReviewRequest) (*v1.Review, error) {
+	r, err := s.service.ModerateReview(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return r.ApiV1(), nil
+}
+
+func (s Server) ListReviewRevisions(ctx context.Context, req *v1.ListReviewRevisionsRequest) (*v1.ListReviewRevisionsResponse, error) {
+	revisions, err := s.service.ListReviewRevisions(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var data []*v1.ReviewRevision
+	for _, r := range revisions {
+		data = append(data, r.ApiV1())
+	}
+	return &v1.ListReviewRevisionsResponse{Revisions: data}, nil
+}
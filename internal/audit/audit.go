@@ -0,0 +1,105 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+const unknownActor = "unknown"
+
+type actorKey struct{}
+
+// WithActor returns a copy of ctx carrying the actor performing the operation.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor set by WithActor, which is only set for
+// authenticated callers. It returns "unknown" when it is not set.
+func ActorFromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey{}).(string); ok && actor != "" {
+		return actor
+	}
+	return unknownActor
+}
+
+// Entry is a single record of the audit trail.
+type Entry struct {
+	ID        uuid.UUID
+	Actor     string
+	Action    string
+	Resource  string
+	Details   map[string]any
+	CreatedAt time.Time
+}
+
+func NewEntry(ctx context.Context, action, resource string, details map[string]any) Entry {
+	return Entry{
+		ID:        uuid.New(),
+		Actor:     ActorFromContext(ctx),
+		Action:    action,
+		Resource:  resource,
+		Details:   details,
+		CreatedAt: time.Now(),
+	}
+}
+
+func NewStore(db *sqlx.DB) *Store {
+	return &Store{
+		db:      db,
+		dbCache: sq.NewStmtCache(db),
+	}
+}
+
+// Store persists audit entries. Entries are append only.
+type Store struct {
+	db      *sqlx.DB
+	dbCache *sq.StmtCache
+}
+
+func (s *Store) Clear() error {
+	return s.dbCache.Clear()
+}
+
+type RecordOptions struct {
+	Tx *sqlx.Tx
+}
+
+type RecordOption func(*RecordOptions)
+
+// WithRecordTx records the entry as part of the given transaction so that the
+// entry is only kept when the audited change is committed.
+func WithRecordTx(tx *sqlx.Tx) RecordOption {
+	return func(o *RecordOptions) {
+		o.Tx = tx
+	}
+}
+
+func (s *Store) Record(ctx context.Context, e Entry, opts ...RecordOption) error {
+	options := &RecordOptions{}
+	for _, o := range opts {
+		o(options)
+	}
+
+	details, err := json.Marshal(e.Details)
+	if err != nil {
+		return err
+	}
+
+	sb := sq.StatementBuilder.RunWith(s.dbCache)
+	if options.Tx != nil {
+		sb = sb.RunWith(options.Tx)
+	}
+	insertEntry := sb.Insert("audit_entries").
+		Columns("id", "actor", "action", "resource", "details", "created_at").
+		Values(e.ID, e.Actor, e.Action, e.Resource, details, e.CreatedAt).
+		PlaceholderFormat(sq.Dollar)
+
+	_, err = insertEntry.ExecContext(ctx)
+	return err
+}
@@ -0,0 +1,204 @@
+// Package auth authenticates the callers of the API from the bearer token of
+// their requests.
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ErrUnauthenticated struct {
+	Message string
+}
+
+func (e ErrUnauthenticated) Error() string {
+	return e.Message
+}
+
+func (e ErrUnauthenticated) GRPCStatus() *status.Status {
+	return status.New(codes.Unauthenticated, e.Error())
+}
+
+type ErrPermissionDenied struct {
+	Message string
+}
+
+func (e ErrPermissionDenied) Error() string {
+	return e.Message
+}
+
+func (e ErrPermissionDenied) GRPCStatus() *status.Status {
+	return status.New(codes.PermissionDenied, e.Error())
+}
+
+// Principal is the authenticated caller. The zero Principal is an anonymous
+// caller.
+type Principal struct {
+	// Admin is set for the holders of the admin token.
+	Admin bool
+	// Customer is the id of the customer of a customer token.
+	Customer string
+}
+
+// Actor returns the name of the caller in the audit trail, empty for
+// anonymous callers. admin names the holder of the admin token.
+func (p Principal) Actor(admin string) string {
+	switch {
+	case p.Admin:
+		return admin
+	case p.Customer != "":
+		return "customer:" + p.Customer
+	}
+	return ""
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a copy of ctx carrying the authenticated caller.
+func WithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext returns the caller set by WithPrincipal, the anonymous caller
+// when it is not set.
+func FromContext(ctx context.Context) Principal {
+	p, _ := ctx.Value(principalKey{}).(Principal)
+	return p
+}
+
+// CustomerFromContext returns the id of the customer authenticated by a
+// customer token, false for other callers.
+func CustomerFromContext(ctx context.Context) (string, bool) {
+	p := FromContext(ctx)
+	return p.Customer, p.Customer != ""
+}
+
+// IsAdmin reports whether the caller of ctx holds the admin token.
+func IsAdmin(ctx context.Context) bool {
+	return FromContext(ctx).Admin
+}
+
+// RequireAdmin returns an error unless the caller of ctx holds the admin token.
+func RequireAdmin(ctx context.Context) error {
+	switch p := FromContext(ctx); {
+	case p.Admin:
+		return nil
+	case p == Principal{}:
+		return ErrUnauthenticated{Message: "admin token required"}
+	default:
+		return ErrPermissionDenied{Message: "admin token required"}
+	}
+}
+
+// RequireCustomer returns an error unless the caller of ctx is the customer
+// id or holds the admin token.
+func RequireCustomer(ctx context.Context, id string) error {
+	switch p := FromContext(ctx); {
+	case p.Admin || p.Customer == id:
+		return nil
+	case p == Principal{}:
+		return ErrUnauthenticated{Message: "customer token required"}
+	default:
+		return ErrPermissionDenied{Message: "not allowed to act for customer " + id}
+	}
+}
+
+// Authenticator checks the bearer tokens of the requests.
+type Authenticator struct {
+	adminToken     []byte
+	customerSecret []byte
+}
+
+// NewAuthenticator returns the authenticator of adminToken and of the
+// customer tokens signed with customerSecret. Nobody is an admin when
+// adminToken is empty, and customer tokens are refused when customerSecret
+// is empty.
+func NewAuthenticator(adminToken, customerSecret string) *Authenticator {
+	return &Authenticator{adminToken: []byte(adminToken), customerSecret: []byte(customerSecret)}
+}
+
+// Authenticate returns the caller of the Authorization value of a request,
+// such as `Bearer <token>`. Callers without authorization are anonymous, an
+// error is returned for a malformed, expired or unknown token.
+func (a *Authenticator) Authenticate(authorization string) (Principal, error) {
+	if authorization == "" {
+		return Principal{}, nil
+	}
+	token, ok := strings.CutPrefix(authorization, "Bearer ")
+	if !ok || token == "" {
+		return Principal{}, ErrUnauthenticated{Message: "authorization must be a bearer token"}
+	}
+	if len(a.adminToken) > 0 && subtle.ConstantTimeCompare([]byte(token), a.adminToken) == 1 {
+		return Principal{Admin: true}, nil
+	}
+	if len(a.customerSecret) > 0 && strings.Contains(token, ".") {
+		customer, err := a.verifyCustomerToken(token, time.Now())
+		if err != nil {
+			return Principal{}, err
+		}
+		return Principal{Customer: customer}, nil
+	}
+	return Principal{}, ErrUnauthenticated{Message: "invalid token"}
+}
+
+// customerClaims is the payload of a customer token.
+type customerClaims struct {
+	Subject string `json:"sub"`
+	Expiry  int64  `json:"exp"`
+}
+
+// IssueCustomerToken returns a token authenticating the customer id until
+// expiry.
+func (a *Authenticator) IssueCustomerToken(id string, expiry time.Time) (string, error) {
+	if len(a.customerSecret) == 0 {
+		return "", errors.New("customer token secret is not set")
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return "", errors.New("customer must be a valid customer id")
+	}
+	payload, err := json.Marshal(customerClaims{Subject: id, Expiry: expiry.Unix()})
+	if err != nil {
+		return "", err
+	}
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString(payload) + "." + enc.EncodeToString(a.sign(payload)), nil
+}
+
+func (a *Authenticator) verifyCustomerToken(token string, now time.Time) (string, error) {
+	enc := base64.RawURLEncoding
+	payloadPart, sigPart, _ := strings.Cut(token, ".")
+	payload, err := enc.DecodeString(payloadPart)
+	if err != nil {
+		return "", ErrUnauthenticated{Message: "malformed token"}
+	}
+	sig, err := enc.DecodeString(sigPart)
+	if err != nil || !hmac.Equal(sig, a.sign(payload)) {
+		return "", ErrUnauthenticated{Message: "invalid token"}
+	}
+	var claims customerClaims
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Subject == "" {
+		return "", ErrUnauthenticated{Message: "malformed token"}
+	}
+	if now.Unix() >= claims.Expiry {
+		return "", ErrUnauthenticated{Message: "token expired"}
+	}
+	return claims.Subject, nil
+}
+
+func (a *Authenticator) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, a.customerSecret)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
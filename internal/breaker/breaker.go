@@ -0,0 +1,131 @@
+package breaker
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrOpen is returned instead of calling a dependency while the breaker is open.
+var ErrOpen = errors.New("circuit breaker is open")
+
+type State int
+
+const (
+	// Closed lets all calls through.
+	Closed State = iota
+	// Open rejects all calls until the open duration has passed.
+	Open
+	// HalfOpen lets a single probe call through. The breaker closes when it
+	// succeeds and opens again when it fails.
+	HalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case Open:
+		return "open"
+	case HalfOpen:
+		return "half-open"
+	default:
+		return "closed"
+	}
+}
+
+// New returns a breaker which opens after threshold consecutive failures and
+// stays open for openDuration. onChange, when not nil, is called on every
+// state change while the breaker is locked, so it must not use the breaker.
+func New(threshold int, openDuration time.Duration, onChange func(from, to State)) *Breaker {
+	if threshold <= 0 {
+		threshold = 1
+	}
+	return &Breaker{
+		threshold:    threshold,
+		openDuration: openDuration,
+		onChange:     onChange,
+	}
+}
+
+// Breaker stops calling a failing dependency for a while, so that callers
+// fail fast and fall back instead of waiting for timeouts.
+type Breaker struct {
+	threshold    int
+	openDuration time.Duration
+	onChange     func(from, to State)
+
+	mu       sync.Mutex
+	state    State
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+// Allow returns ErrOpen when the call must not be done. Otherwise the result
+// of the call must be reported with Done.
+func (b *Breaker) Allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case Open:
+		if time.Since(b.openedAt) < b.openDuration {
+			return ErrOpen
+		}
+		b.setState(HalfOpen)
+		b.probing = true
+		return nil
+	case HalfOpen:
+		if b.probing {
+			return ErrOpen
+		}
+		b.probing = true
+	}
+	return nil
+}
+
+// Done reports whether an allowed call failed.
+func (b *Breaker) Done(failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == HalfOpen {
+		b.probing = false
+		if failed {
+			b.open()
+		} else {
+			b.failures = 0
+			b.setState(Closed)
+		}
+		return
+	}
+	if !failed {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.state == Closed && b.failures >= b.threshold {
+		b.open()
+	}
+}
+
+// State returns the current state.
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+func (b *Breaker) open() {
+	b.openedAt = time.Now()
+	b.failures = 0
+	b.setState(Open)
+}
+
+func (b *Breaker) setState(s State) {
+	if b.state == s {
+		return
+	}
+	from := b.state
+	b.state = s
+	if b.onChange != nil {
+		b.onChange(from, s)
+	}
+}
@@ -0,0 +1,81 @@
+package breaker
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestBreakerOpensAfterThreshold(t *testing.T) {
+	var changes []State
+	b := New(3, time.Hour, func(_, to State) { changes = append(changes, to) })
+
+	for i := 0; i < 2; i++ {
+		if err := b.Allow(); err != nil {
+			t.Fatalf("allow before threshold: %v", err)
+		}
+		b.Done(true)
+	}
+	// a success resets the consecutive failures.
+	b.Allow()
+	b.Done(false)
+	for i := 0; i < 2; i++ {
+		b.Allow()
+		b.Done(true)
+	}
+	if s := b.State(); s != Closed {
+		t.Fatalf("state = %s, want closed", s)
+	}
+
+	b.Allow()
+	b.Done(true)
+	if s := b.State(); s != Open {
+		t.Fatalf("state = %s, want open", s)
+	}
+	if err := b.Allow(); !errors.Is(err, ErrOpen) {
+		t.Fatalf("allow while open = %v, want ErrOpen", err)
+	}
+	if len(changes) != 1 || changes[0] != Open {
+		t.Fatalf("changes = %v, want [open]", changes)
+	}
+}
+
+func TestBreakerProbesAfterOpenDuration(t *testing.T) {
+	var changes []State
+	b := New(1, 10*time.Millisecond, func(_, to State) { changes = append(changes, to) })
+	b.Allow()
+	b.Done(true)
+	time.Sleep(20 * time.Millisecond)
+
+	if err := b.Allow(); err != nil {
+		t.Fatalf("allow of the probe: %v", err)
+	}
+	if s := b.State(); s != HalfOpen {
+		t.Fatalf("state = %s, want half-open", s)
+	}
+	if err := b.Allow(); !errors.Is(err, ErrOpen) {
+		t.Fatalf("allow during the probe = %v, want ErrOpen", err)
+	}
+
+	// a failed probe opens the breaker again.
+	b.Done(true)
+	if err := b.Allow(); !errors.Is(err, ErrOpen) {
+		t.Fatalf("allow after failed probe = %v, want ErrOpen", err)
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	b.Allow()
+	b.Done(false)
+	if s := b.State(); s != Closed {
+		t.Fatalf("state = %s, want closed", s)
+	}
+	want := []State{Open, HalfOpen, Open, HalfOpen, Closed}
+	if len(changes) != len(want) {
+		t.Fatalf("changes = %v, want %v", changes, want)
+	}
+	for i := range want {
+		if changes[i] != want[i] {
+			t.Fatalf("changes = %v, want %v", changes, want)
+		}
+	}
+}
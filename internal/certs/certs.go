@@ -0,0 +1,222 @@
+// Package certs builds TLS configs from config.TLS whose certificates are read
+// again when their files change.
+package certs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/imrenagicom/demo-app/internal/config"
+
+	"github.com/rs/zerolog/log"
+)
+
+// checkInterval is how often the files are checked for changes.
+var checkInterval = 10 * time.Second
+
+// NewReloader loads the certificate and key when certFile is not empty, and
+// the CAs of caFile when it is not empty.
+func NewReloader(certFile, keyFile, caFile string) (*Reloader, error) {
+	r := &Reloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+		caFile:   caFile,
+	}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reloader serves a certificate and a CA pool, reloading them when the
+// modification time of one of their files changes. When a reload fails, the
+// previous certificate is kept.
+type Reloader struct {
+	certFile string
+	keyFile  string
+	caFile   string
+
+	mu       sync.Mutex
+	cert     *tls.Certificate
+	pool     *x509.CertPool
+	modTimes [3]time.Time
+	checked  time.Time
+}
+
+func (r *Reloader) load() error {
+	modTimes, err := r.stat()
+	if err != nil {
+		return err
+	}
+	var cert *tls.Certificate
+	if r.certFile != "" {
+		c, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+		if err != nil {
+			return err
+		}
+		cert = &c
+	}
+	var pool *x509.CertPool
+	if r.caFile != "" {
+		if pool, err = loadPool(r.caFile); err != nil {
+			return err
+		}
+	}
+	r.cert, r.pool, r.modTimes = cert, pool, modTimes
+	r.checked = time.Now()
+	return nil
+}
+
+func (r *Reloader) stat() ([3]time.Time, error) {
+	var modTimes [3]time.Time
+	for i, f := range []string{r.certFile, r.keyFile, r.caFile} {
+		if f == "" {
+			continue
+		}
+		fi, err := os.Stat(f)
+		if err != nil {
+			return modTimes, err
+		}
+		modTimes[i] = fi.ModTime()
+	}
+	return modTimes, nil
+}
+
+// current returns the certificate and the pool, reloading them first when
+// their files changed.
+func (r *Reloader) current() (*tls.Certificate, *x509.CertPool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if time.Since(r.checked) < checkInterval {
+		return r.cert, r.pool
+	}
+	r.checked = time.Now()
+	modTimes, err := r.stat()
+	if err != nil {
+		log.Error().Err(err).Str("cert", r.certFile).Msg("unable to check certificate files")
+		return r.cert, r.pool
+	}
+	if modTimes == r.modTimes {
+		return r.cert, r.pool
+	}
+	if err := r.load(); err != nil {
+		log.Error().Err(err).Str("cert", r.certFile).Msg("unable to reload certificate, keeping the previous one")
+		return r.cert, r.pool
+	}
+	log.Info().Str("cert", r.certFile).Str("ca", r.caFile).Msg("certificate reloaded")
+	return r.cert, r.pool
+}
+
+func (r *Reloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	cert, _ := r.current()
+	return cert, nil
+}
+
+func (r *Reloader) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	cert, _ := r.current()
+	return cert, nil
+}
+
+func loadPool(file string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no certificate found in %s", file)
+	}
+	return pool, nil
+}
+
+// ServerConfig returns the TLS config of a listener. Clients must present a
+// certificate signed by the client CAs when c.ClientCAFile is set.
+func ServerConfig(c config.TLS) (*tls.Config, error) {
+	if !c.Enabled {
+		return nil, errors.New("tls is not enabled")
+	}
+	r, err := NewReloader(c.CertFile, c.KeyFile, c.ClientCAFile)
+	if err != nil {
+		return nil, err
+	}
+	base := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: r.GetCertificate,
+		NextProtos:     []string{"h2", "http/1.1"},
+	}
+	if c.ClientCAFile == "" {
+		return base, nil
+	}
+	base.ClientAuth = tls.RequireAndVerifyClientCert
+	// the client CAs are only read when the handshake starts, so they are
+	// taken from the reloader for every connection.
+	base.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		_, pool := r.current()
+		cfg := base.Clone()
+		cfg.GetConfigForClient = nil
+		cfg.ClientCAs = pool
+		return cfg, nil
+	}
+	return base, nil
+}
+
+// ClientConfig returns the TLS config dialing the server configured by c. The
+// server certificate is verified against c.CAFile, or the system roots when
+// it is empty. The server certificate is presented as client certificate when
+// the server requires one. Both are read again when their files change.
+func ClientConfig(c config.TLS) (*tls.Config, error) {
+	if !c.Enabled {
+		return nil, errors.New("tls is not enabled")
+	}
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: c.ServerName,
+	}
+	var certFile, keyFile string
+	if c.ClientCAFile != "" {
+		certFile, keyFile = c.CertFile, c.KeyFile
+	}
+	if certFile == "" && c.CAFile == "" {
+		return cfg, nil
+	}
+	r, err := NewReloader(certFile, keyFile, c.CAFile)
+	if err != nil {
+		return nil, err
+	}
+	if certFile != "" {
+		cfg.GetClientCertificate = r.GetClientCertificate
+	}
+	if c.CAFile != "" {
+		// the root CAs are fixed once the config is used, so the server
+		// certificate is verified against the CAs of the reloader instead.
+		cfg.InsecureSkipVerify = true
+		cfg.VerifyConnection = func(cs tls.ConnectionState) error {
+			_, pool := r.current()
+			return verifyServer(cs, pool)
+		}
+	}
+	return cfg, nil
+}
+
+// verifyServer verifies the certificate of the server of cs as the handshake
+// does with tls.Config.RootCAs set to roots.
+func verifyServer(cs tls.ConnectionState, roots *x509.CertPool) error {
+	if len(cs.PeerCertificates) == 0 {
+		return errors.New("server presented no certificate")
+	}
+	opts := x509.VerifyOptions{
+		Roots:         roots,
+		DNSName:       cs.ServerName,
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, cert := range cs.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	_, err := cs.PeerCertificates[0].Verify(opts)
+	return err
+}
@@ -0,0 +1,258 @@
+package certs
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/imrenagicom/demo-app/internal/config"
+)
+
+// authority is a CA issuing the certificates of a test.
+type authority struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newAuthority(t *testing.T, name string) *authority {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &authority{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue returns the PEM certificate and key of localhost, usable by servers
+// and clients.
+func (a *authority) issue(t *testing.T, serial int64) ([]byte, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, a.cert, &key.PublicKey, a.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+// writeFile writes data to name in dir and moves its modification time
+// forward, so that the change is seen on file systems with coarse times.
+func writeFile(t *testing.T, dir, name string, data []byte, age time.Duration) string {
+	t.Helper()
+	f := filepath.Join(dir, name)
+	if err := os.WriteFile(f, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	mod := time.Now().Add(age)
+	if err := os.Chtimes(f, mod, mod); err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+// checkAlways makes the reloaders check their files on every use.
+func checkAlways(t *testing.T) {
+	interval := checkInterval
+	checkInterval = 0
+	t.Cleanup(func() { checkInterval = interval })
+}
+
+func serial(t *testing.T, cert *tls.Certificate) int64 {
+	t.Helper()
+	c, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c.SerialNumber.Int64()
+}
+
+func TestReloaderReloadsChangedFiles(t *testing.T) {
+	checkAlways(t)
+	dir := t.TempDir()
+	ca := newAuthority(t, "ca")
+	certPEM, keyPEM := ca.issue(t, 10)
+	certFile := writeFile(t, dir, "tls.crt", certPEM, -time.Hour)
+	keyFile := writeFile(t, dir, "tls.key", keyPEM, -time.Hour)
+
+	r, err := NewReloader(certFile, keyFile, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, _ := r.GetCertificate(nil)
+	if got := serial(t, cert); got != 10 {
+		t.Fatalf("serial = %d, want 10", got)
+	}
+
+	certPEM, keyPEM = ca.issue(t, 11)
+	writeFile(t, dir, "tls.crt", certPEM, 0)
+	writeFile(t, dir, "tls.key", keyPEM, 0)
+	cert, _ = r.GetCertificate(nil)
+	if got := serial(t, cert); got != 11 {
+		t.Fatalf("serial after reload = %d, want 11", got)
+	}
+
+	// a broken certificate keeps the previous one.
+	writeFile(t, dir, "tls.crt", []byte("not a certificate"), time.Hour)
+	cert, _ = r.GetCertificate(nil)
+	if got := serial(t, cert); got != 11 {
+		t.Fatalf("serial after failed reload = %d, want 11", got)
+	}
+}
+
+// handshake connects a client with clientCfg to a server with serverCfg and
+// returns the error of the handshake of the server.
+func handshake(t *testing.T, serverCfg, clientCfg *tls.Config) error {
+	t.Helper()
+	ln, err := tls.Listen("tcp", "127.0.0.1:0", serverCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	done := make(chan error, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			done <- err
+			return
+		}
+		defer conn.Close()
+		done <- conn.(*tls.Conn).Handshake()
+	}()
+
+	conn, err := tls.Dial("tcp", ln.Addr().String(), clientCfg)
+	if err == nil {
+		// the client finishes its handshake before the server verifies
+		// its certificate, which is reported by the first read.
+		conn.SetReadDeadline(time.Now().Add(100 * time.Millisecond))
+		conn.Read(make([]byte, 1))
+		conn.Close()
+	}
+	serverErr := <-done
+	if err != nil {
+		return err
+	}
+	return serverErr
+}
+
+func TestMutualTLSHandshake(t *testing.T) {
+	dir := t.TempDir()
+	ca := newAuthority(t, "ca")
+	certPEM, keyPEM := ca.issue(t, 10)
+	c := config.TLS{
+		Enabled:      true,
+		CertFile:     writeFile(t, dir, "tls.crt", certPEM, 0),
+		KeyFile:      writeFile(t, dir, "tls.key", keyPEM, 0),
+		ClientCAFile: writeFile(t, dir, "ca.crt", ca.pem, 0),
+		CAFile:       filepath.Join(dir, "ca.crt"),
+		ServerName:   "localhost",
+	}
+	serverCfg, err := ServerConfig(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clientCfg, err := ClientConfig(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := handshake(t, serverCfg, clientCfg); err != nil {
+		t.Fatalf("handshake with client certificate: %v", err)
+	}
+
+	anonymous := c
+	anonymous.ClientCAFile = ""
+	clientCfg, err = ClientConfig(anonymous)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := handshake(t, serverCfg, clientCfg); err == nil {
+		t.Fatal("handshake without client certificate succeeded")
+	}
+
+	untrusted := c
+	untrusted.CAFile = writeFile(t, dir, "other.crt", newAuthority(t, "other").pem, 0)
+	clientCfg, err = ClientConfig(untrusted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := handshake(t, serverCfg, clientCfg); err == nil {
+		t.Fatal("handshake with a server of another CA succeeded")
+	}
+}
+
+func TestClientConfigReloadsCA(t *testing.T) {
+	checkAlways(t)
+	dir := t.TempDir()
+	ca, next := newAuthority(t, "ca"), newAuthority(t, "next")
+	certPEM, keyPEM := next.issue(t, 10)
+	serverCfg, err := ServerConfig(config.TLS{
+		Enabled:  true,
+		CertFile: writeFile(t, dir, "tls.crt", certPEM, 0),
+		KeyFile:  writeFile(t, dir, "tls.key", keyPEM, 0),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clientCfg, err := ClientConfig(config.TLS{
+		Enabled:    true,
+		CAFile:     writeFile(t, dir, "ca.crt", ca.pem, -time.Hour),
+		ServerName: "localhost",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := handshake(t, serverCfg, clientCfg); err == nil {
+		t.Fatal("handshake with a server of a CA not trusted yet succeeded")
+	}
+
+	writeFile(t, dir, "ca.crt", next.pem, 0)
+	if err := handshake(t, serverCfg, clientCfg); err != nil {
+		t.Fatalf("handshake after the CA changed: %v", err)
+	}
+}
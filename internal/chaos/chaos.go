@@ -0,0 +1,166 @@
+package chaos
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"math/rand"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/imrenagicom/demo-app/internal/config"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// New returns an Injector of the faults of c.
+func New(c config.Chaos) *Injector {
+	i := &Injector{}
+	i.Configure(c)
+	return i
+}
+
+// Injector injects faults into the calls of the targets of its config. A nil
+// Injector injects nothing, so stores and servers may use it unconditionally.
+type Injector struct {
+	mu     sync.Mutex
+	config config.Chaos
+	rand   *rand.Rand
+}
+
+// Configure replaces the config, reseeding the injector.
+func (i *Injector) Configure(c config.Chaos) {
+	seed := c.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.config = c
+	i.rand = rand.New(rand.NewSource(seed))
+}
+
+// Config returns the current config.
+func (i *Injector) Config() config.Chaos {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.config
+}
+
+// Inject applies the first fault matching target: it sleeps for the latency
+// of the fault and returns its error. Nothing is done when chaos is disabled,
+// no fault matches or the fault does not happen this time. The sleep ends
+// early with the error of ctx when ctx is done.
+func (i *Injector) Inject(ctx context.Context, target string) error {
+	if i == nil {
+		return nil
+	}
+	f, delay, ok := i.decide(target)
+	if !ok {
+		return nil
+	}
+	log.Ctx(ctx).Debug().Str("target", target).Dur("latency", delay).Str("error", f.Error).Msg("injecting fault")
+	if delay > 0 {
+		t := time.NewTimer(delay)
+		defer t.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+	return faultError(f, target)
+}
+
+// decide draws whether the fault of target happens and its latency. Draws
+// share the seeded source, so they are taken under the lock.
+func (i *Injector) decide(target string) (config.Fault, time.Duration, bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if !i.config.Enabled {
+		return config.Fault{}, 0, false
+	}
+	for _, f := range i.config.Faults {
+		if matched, _ := path.Match(f.Target, target); !matched {
+			continue
+		}
+		if i.rand.Float64() >= f.Probability {
+			return f, 0, false
+		}
+		return f, i.latency(f.Latency), true
+	}
+	return config.Fault{}, 0, false
+}
+
+func (i *Injector) latency(l config.Latency) time.Duration {
+	var ms float64
+	switch l.Distribution {
+	case "fixed":
+		return time.Duration(l.MeanMs) * time.Millisecond
+	case "uniform":
+		ms = float64(l.MinMs) + i.rand.Float64()*float64(l.MaxMs-l.MinMs)
+	case "normal":
+		ms = float64(l.MeanMs) + i.rand.NormFloat64()*float64(l.StdDevMs)
+	case "exponential":
+		ms = i.rand.ExpFloat64() * float64(l.MeanMs)
+	default:
+		return 0
+	}
+	ms = math.Max(ms, float64(l.MinMs))
+	if l.MaxMs > 0 {
+		ms = math.Min(ms, float64(l.MaxMs))
+	}
+	return time.Duration(ms * float64(time.Millisecond))
+}
+
+// Error is an injected error.
+type Error struct {
+	Target string
+	Code   codes.Code
+	// cause is matched by errors.Is, so that injected failures are handled
+	// as the failures they stand for.
+	cause error
+}
+
+func (e Error) Error() string {
+	if e.cause != nil {
+		return fmt.Sprintf("chaos: injected failure in %s: %v", e.Target, e.cause)
+	}
+	return fmt.Sprintf("chaos: injected %s error in %s", e.Code, e.Target)
+}
+
+func (e Error) Unwrap() error {
+	return e.cause
+}
+
+func (e Error) GRPCStatus() *status.Status {
+	return status.New(e.Code, e.Error())
+}
+
+// ErrRedisUnavailable stands for an unreachable redis. It is a timeout
+// net.Error, as returned by the redis client.
+var ErrRedisUnavailable error = redisUnavailable{}
+
+type redisUnavailable struct{}
+
+func (redisUnavailable) Error() string   { return "redis is unreachable" }
+func (redisUnavailable) Timeout() bool   { return true }
+func (redisUnavailable) Temporary() bool { return true }
+
+func faultError(f config.Fault, target string) error {
+	switch f.Error {
+	case "internal":
+		return Error{Target: target, Code: codes.Internal}
+	case "unavailable":
+		return Error{Target: target, Code: codes.Unavailable}
+	case "db":
+		return Error{Target: target, Code: codes.Unavailable, cause: driver.ErrBadConn}
+	case "redis":
+		return Error{Target: target, Code: codes.Unavailable, cause: ErrRedisUnavailable}
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package chaos
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/imrenagicom/demo-app/internal/config"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestInjectMatchesTargets(t *testing.T) {
+	i := New(config.Chaos{
+		Enabled: true,
+		Seed:    1,
+		Faults: []config.Fault{
+			{Target: "booking.Store.*", Probability: 1, Error: "db"},
+			{Target: "/svc.v1.BookingService/*", Probability: 1, Error: "unavailable"},
+		},
+	})
+	ctx := context.Background()
+
+	err := i.Inject(ctx, "booking.Store.FindBookingByID")
+	if !errors.Is(err, driver.ErrBadConn) {
+		t.Fatalf("store fault = %v, want driver.ErrBadConn", err)
+	}
+	err = i.Inject(ctx, "/svc.v1.BookingService/ReserveBooking")
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("grpc fault = %v, want UNAVAILABLE", err)
+	}
+	if err := i.Inject(ctx, "catalog.Store.FindCourseByID"); err != nil {
+		t.Fatalf("unmatched target = %v, want nil", err)
+	}
+}
+
+func TestInjectNothing(t *testing.T) {
+	fault := config.Fault{Target: "*", Probability: 1, Error: "internal"}
+	for _, tt := range []struct {
+		name string
+		i    *Injector
+	}{
+		{"nil injector", nil},
+		{"disabled", New(config.Chaos{Faults: []config.Fault{fault}})},
+		{"never happens", New(config.Chaos{Enabled: true, Faults: []config.Fault{{Target: "*", Error: "internal"}}})},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.i.Inject(context.Background(), "booking.Store.FindBookingByID"); err != nil {
+				t.Fatalf("Inject = %v, want nil", err)
+			}
+		})
+	}
+}
+
+func TestSeedReproducesDecisions(t *testing.T) {
+	c := config.Chaos{
+		Enabled: true,
+		Seed:    42,
+		Faults: []config.Fault{{
+			Target:      "*",
+			Probability: 0.5,
+			Latency:     config.Latency{Distribution: "uniform", MinMs: 0, MaxMs: 100},
+		}},
+	}
+	draw := func() []time.Duration {
+		i := New(c)
+		var ds []time.Duration
+		for n := 0; n < 20; n++ {
+			_, d, ok := i.decide("target")
+			if !ok {
+				d = -1
+			}
+			ds = append(ds, d)
+		}
+		return ds
+	}
+	first, second := draw(), draw()
+	for n := range first {
+		if first[n] != second[n] {
+			t.Fatalf("draw %d = %s then %s, want the same with the same seed", n, first[n], second[n])
+		}
+	}
+}
+
+func TestLatencyIsBounded(t *testing.T) {
+	i := New(config.Chaos{Seed: 1})
+	for _, l := range []config.Latency{
+		{Distribution: "uniform", MinMs: 10, MaxMs: 20},
+		{Distribution: "normal", MeanMs: 15, StdDevMs: 50, MinMs: 10, MaxMs: 20},
+		{Distribution: "exponential", MeanMs: 15, MinMs: 10, MaxMs: 20},
+	} {
+		for n := 0; n < 100; n++ {
+			if d := i.latency(l); d < 10*time.Millisecond || d > 20*time.Millisecond {
+				t.Fatalf("%s latency = %s, want between 10ms and 20ms", l.Distribution, d)
+			}
+		}
+	}
+	if d := i.latency(config.Latency{Distribution: "fixed", MeanMs: 30}); d != 30*time.Millisecond {
+		t.Fatalf("fixed latency = %s, want 30ms", d)
+	}
+}
+
+func TestInjectStopsWithContext(t *testing.T) {
+	i := New(config.Chaos{
+		Enabled: true,
+		Faults:  []config.Fault{{Target: "*", Probability: 1, Latency: config.Latency{Distribution: "fixed", MeanMs: 60000}}},
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := i.Inject(ctx, "target"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Inject = %v, want context.DeadlineExceeded", err)
+	}
+}
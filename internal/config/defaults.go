@@ -0,0 +1,125 @@
+package config
+
+import "github.com/spf13/viper"
+
+// setServerDefaults sets the default of every key of Server. Keys need a
+// default to be read from the environment when the file does not have them.
+func setServerDefaults(v *viper.Viper) {
+	defaults := map[string]any{
+		"grpc.host":  "",
+		"grpc.port":  "9900",
+		"http.host":  "",
+		"http.port":  "8800",
+		"singlePort": false,
+
+		"log.level":          "info",
+		"log.type":           "json",
+		"log.logFileEnabled": false,
+		"log.logFilePath":    "logs/app.log",
+
+		"log.rotation.maxSizeMB":        100,
+		"log.rotation.intervalHours":    0,
+		"log.rotation.maxBackups":       5,
+		"log.rotation.maxAgeDays":       7,
+		"log.sampling.enabled":          false,
+		"log.sampling.trace.burst":      0,
+		"log.sampling.trace.periodMs":   0,
+		"log.sampling.trace.thereafter": 0,
+		"log.sampling.debug.burst":      0,
+		"log.sampling.debug.periodMs":   0,
+		"log.sampling.debug.thereafter": 0,
+		"log.sampling.info.burst":       0,
+		"log.sampling.info.periodMs":    0,
+		"log.sampling.info.thereafter":  0,
+		"log.push.type":                 "",
+		"log.push.url":                  "",
+		"log.push.labels":               map[string]string{},
+		"log.push.batchSize":            500,
+		"log.push.flushIntervalMs":      1000,
+		"log.push.bufferSize":           10000,
+		"log.push.timeoutSec":           5,
+
+		"db.host":         "127.0.0.1",
+		"db.port":         "5432",
+		"db.name":         "course",
+		"db.user":         "course",
+		"db.password":     "",
+		"db.passwordFile": "",
+		"db.maxIdleConn":  10,
+		"db.maxOpenConn":  20,
+
+		"db.replicas":                []string{},
+		"db.replicaCheckIntervalSec": 5,
+		"db.replicaMaxLagSec":        0,
+
+		"db.connectRetry.maxAttempts":          10,
+		"db.connectRetry.initialBackoffMs":     500,
+		"db.connectRetry.maxBackoffMs":         5000,
+		"db.transactionRetry.maxAttempts":      3,
+		"db.transactionRetry.initialBackoffMs": 20,
+		"db.transactionRetry.maxBackoffMs":     200,
+		"db.readRetry.maxAttempts":             2,
+		"db.readRetry.initialBackoffMs":        50,
+		"db.readRetry.maxBackoffMs":            200,
+
+		"redis.host":               "127.0.0.1",
+		"redis.port":               "6379",
+		"redis.db":                 0,
+		"redis.password":           "",
+		"redis.passwordFile":       "",
+		"redis.connDialTimeoutSec": 5,
+		"redis.readTimeoutSec":     0,
+		"redis.writeTimeoutSec":    0,
+		"redis.connPoolSize":       0,
+		"redis.connPoolTimeoutSec": 0,
+		"redis.minIdleConn":        0,
+		"redis.maxIdleConn":        0,
+
+		"redis.connectRetry.maxAttempts":      5,
+		"redis.connectRetry.initialBackoffMs": 500,
+		"redis.connectRetry.maxBackoffMs":     5000,
+		"redis.breaker.failureThreshold":      5,
+		"redis.breaker.openSec":               10,
+
+		"booking.reservationStrategy":   "optimistic",
+		"booking.holdDurationSec":       600,
+		"booking.maxReservationRetries": 5,
+		"booking.maxReleaseRetries":     5,
+
+		"rateLimit.enabled":        false,
+		"rateLimit.requestsPerSec": 1000,
+		"rateLimit.burst":          100,
+
+		"reconcile.enabled":     false,
+		"reconcile.intervalSec": 300,
+		"reconcile.fix":         false,
+
+		"scheduler.enabled":     true,
+		"scheduler.intervalSec": 60,
+
+		"chaos.enabled": false,
+		"chaos.seed":    0,
+		"chaos.faults":  []any{},
+
+		"admin.enabled":   false,
+		"admin.token":     "",
+		"admin.tokenFile": "",
+
+		"pagination.cursorSecret":     "",
+		"pagination.cursorSecretFile": "",
+
+		"auth.customerTokenSecret":     "",
+		"auth.customerTokenSecretFile": "",
+	}
+	for _, server := range []string{"grpc", "http"} {
+		defaults[server+".tls.enabled"] = false
+		defaults[server+".tls.certFile"] = ""
+		defaults[server+".tls.keyFile"] = ""
+		defaults[server+".tls.clientCAFile"] = ""
+		defaults[server+".tls.caFile"] = ""
+		defaults[server+".tls.serverName"] = "localhost"
+	}
+	for key, value := range defaults {
+		v.SetDefault(key, value)
+	}
+}
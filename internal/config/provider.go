@@ -0,0 +1,256 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/rs/zerolog/log"
+)
+
+// reloadableKeys are the keys of Server which may change while the server runs.
+var reloadableKeys = map[string]bool{
+	"log.level":                     true,
+	"booking.holdDurationSec":       true,
+	"booking.maxReservationRetries": true,
+	"booking.maxReleaseRetries":     true,
+	"rateLimit.enabled":             true,
+	"rateLimit.requestsPerSec":      true,
+	"rateLimit.burst":               true,
+	"chaos.enabled":                 true,
+	"chaos.seed":                    true,
+	"chaos.faults":                  true,
+}
+
+// secretKeys are the keys whose values are never logged.
+var secretKeys = map[string]bool{
+	"db.password":              true,
+	"db.replicas":              true,
+	"log.push.url":             true,
+	"redis.password":           true,
+	"pagination.cursorSecret":  true,
+	"admin.token":              true,
+	"auth.customerTokenSecret": true,
+}
+
+// reloadDebounce groups the events of a file being written in several steps.
+const reloadDebounce = 500 * time.Millisecond
+
+// ErrNotReloadable is returned when a reload changes keys which need a restart.
+type ErrNotReloadable struct {
+	Changes []Change
+}
+
+func (e ErrNotReloadable) Error() string {
+	keys := make([]string, len(e.Changes))
+	for i, c := range e.Changes {
+		keys[i] = c.Key
+	}
+	return fmt.Sprintf("config changes need a restart: %s", strings.Join(keys, ", "))
+}
+
+// Change is a key whose value differs between two configs. Secret values are redacted.
+type Change struct {
+	Key        string `json:"key"`
+	Old        string `json:"old"`
+	New        string `json:"new"`
+	Reloadable bool   `json:"reloadable"`
+}
+
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %s -> %s", c.Key, c.Old, c.New)
+}
+
+// Diff returns the keys whose values differ between old and new, sorted by key.
+func Diff(old, new Server) []Change {
+	oldValues, newValues := map[string]string{}, map[string]string{}
+	flatten("", reflect.ValueOf(old), oldValues)
+	flatten("", reflect.ValueOf(new), newValues)
+
+	var changes []Change
+	for key, o := range oldValues {
+		n := newValues[key]
+		if o == n {
+			continue
+		}
+		if secretKeys[key] {
+			o, n = redacted, redacted
+		}
+		changes = append(changes, Change{Key: key, Old: o, New: n, Reloadable: reloadableKeys[key]})
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Key < changes[j].Key
+	})
+	return changes
+}
+
+// flatten adds the fields of the struct v to values, keyed by their yaml names.
+func flatten(prefix string, v reflect.Value, values map[string]string) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("yaml"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		key := prefix + name
+		if f.Type.Kind() == reflect.Struct {
+			flatten(key+".", v.Field(i), values)
+			continue
+		}
+		values[key] = fmt.Sprint(v.Field(i).Interface())
+	}
+}
+
+// Loader loads a new config.
+type Loader func() (Server, error)
+
+// NewProvider returns a Provider of s. Reload uses load to read the config
+// again. Reload is disabled when load is nil.
+func NewProvider(s Server, load Loader) *Provider {
+	p := &Provider{load: load}
+	p.current.Store(&s)
+	return p
+}
+
+// Provider serves the current config to the services reading reloadable keys.
+// A reload replaces the whole config at once, so Get always returns a
+// consistent config.
+type Provider struct {
+	current atomic.Pointer[Server]
+	load    Loader
+
+	// mu serializes reloads and guards listeners.
+	mu        sync.Mutex
+	listeners []func(Server)
+}
+
+// Get returns the current config.
+func (p *Provider) Get() Server {
+	return *p.current.Load()
+}
+
+// OnReload registers fn to be called with the new config after each reload.
+func (p *Provider) OnReload(fn func(Server)) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.listeners = append(p.listeners, fn)
+}
+
+// Reload loads and validates the config, and applies it when only reloadable
+// keys changed. Otherwise the current config is kept and ErrNotReloadable is
+// returned.
+func (p *Provider) Reload() ([]Change, error) {
+	if p.load == nil {
+		return nil, errors.New("config reload is not supported")
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	next, err := p.load()
+	if err != nil {
+		return nil, err
+	}
+	changes := Diff(p.Get(), next)
+	var rejected []Change
+	for _, c := range changes {
+		if !c.Reloadable {
+			rejected = append(rejected, c)
+		}
+	}
+	if len(rejected) > 0 {
+		return changes, ErrNotReloadable{Changes: rejected}
+	}
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	p.current.Store(&next)
+	for _, fn := range p.listeners {
+		fn(next)
+	}
+	return changes, nil
+}
+
+// Watch reloads the config on SIGHUP and whenever the file at path changes,
+// until ctx is done. The directory of the file is watched, so that files
+// replaced by renaming, such as mounted config maps, are noticed as well.
+func (p *Provider) Watch(ctx context.Context, path string) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	var events chan fsnotify.Event
+	if path != "" {
+		watcher, err := fsnotify.NewWatcher()
+		if err != nil {
+			log.Error().Err(err).Msg("unable to watch config file, only reloading on SIGHUP")
+		} else {
+			defer watcher.Close()
+			if err := watcher.Add(filepath.Dir(path)); err != nil {
+				log.Error().Err(err).Msg("unable to watch config file, only reloading on SIGHUP")
+			}
+			events = watcher.Events
+		}
+	}
+	name := filepath.Clean(path)
+
+	var debounce <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+			log.Info().Msg("received SIGHUP, reloading config")
+			p.reloadAndLog()
+		case e, ok := <-events:
+			if !ok {
+				events = nil
+				continue
+			}
+			// config maps swap a symlink of the directory instead of the file.
+			if filepath.Clean(e.Name) == name || strings.HasPrefix(filepath.Base(e.Name), "..") {
+				debounce = time.After(reloadDebounce)
+			}
+		case <-debounce:
+			debounce = nil
+			log.Info().Str("path", path).Msg("config file changed, reloading config")
+			p.reloadAndLog()
+		}
+	}
+}
+
+func (p *Provider) reloadAndLog() {
+	changes, err := p.Reload()
+	var notReloadable ErrNotReloadable
+	switch {
+	case errors.As(err, &notReloadable):
+		log.Error().Strs("rejected", changeStrings(notReloadable.Changes)).Strs("changes", changeStrings(changes)).
+			Msg("config not reloaded, restart the server to apply changes of non reloadable keys")
+	case err != nil:
+		log.Error().Err(err).Msg("config not reloaded")
+	case len(changes) == 0:
+		log.Info().Msg("config reloaded, nothing changed")
+	default:
+		log.Info().Strs("changes", changeStrings(changes)).Msg("config reloaded")
+	}
+}
+
+func changeStrings(changes []Change) []string {
+	s := make([]string, len(changes))
+	for i, c := range changes {
+		s[i] = c.String()
+	}
+	return s
+}
@@ -1,30 +1,131 @@
 package config
 
 import (
-	"bytes"
+	"fmt"
+	"net/url"
 	"os"
 	"strings"
 
 	"github.com/spf13/viper"
 )
 
-func NewServer(path, envPrefix string) (Server, error) {
+type Option func(*options)
+
+type options struct {
+	overrides []string
+}
+
+// WithOverrides sets config keys from key=value pairs, such as
+// db.maxOpenConn=50. They take precedence over the file and the environment.
+func WithOverrides(kvs ...string) Option {
+	return func(o *options) {
+		o.overrides = append(o.overrides, kvs...)
+	}
+}
+
+// NewServer loads the server config from, in increasing precedence, the
+// defaults, the yaml file at path, environment variables and overrides. The
+// file is skipped when path is empty. Environment variables are named after
+// the upper case key prefixed by envPrefix, such as COURSE_SERVER_DB_MAXOPENCONN.
+// Secrets are then read from their files and the config is validated.
+func NewServer(path, envPrefix string, opts ...Option) (Server, error) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
 	fang := viper.New()
 	fang.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 	fang.AutomaticEnv()
 	fang.SetEnvPrefix(envPrefix)
 	fang.SetConfigType("yaml")
-	data, err := os.ReadFile(path)
-	if err != nil {
-		return Server{}, err
+	setServerDefaults(fang)
+
+	if path != "" {
+		f, err := os.Open(path)
+		if err != nil {
+			return Server{}, err
+		}
+		defer f.Close()
+		if err := fang.ReadConfig(f); err != nil {
+			return Server{}, fmt.Errorf("read config %s: %w", path, err)
+		}
 	}
-	if err := fang.ReadConfig(bytes.NewBuffer(data)); err != nil {
-		return Server{}, err
+	for _, kv := range o.overrides {
+		key, value, ok := strings.Cut(kv, "=")
+		if !ok || key == "" {
+			return Server{}, fmt.Errorf("invalid override %q, expected key=value", kv)
+		}
+		if !fang.IsSet(key) {
+			return Server{}, fmt.Errorf("invalid override %q, unknown key %s", kv, key)
+		}
+		fang.Set(key, value)
 	}
+
 	// Load configuration
 	s := Server{}
-	if err = fang.Unmarshal(&s); err != nil {
+	if err := fang.Unmarshal(&s); err != nil {
+		return Server{}, err
+	}
+	if err := s.loadSecrets(); err != nil {
+		return Server{}, err
+	}
+	if err := s.Validate(); err != nil {
 		return Server{}, err
 	}
 	return s, nil
-}
\ No newline at end of file
+}
+
+func (s *Server) loadSecrets() error {
+	secrets := []struct {
+		key   string
+		value *string
+		file  string
+	}{
+		{"db.password", &s.DB.Password, s.DB.PasswordFile},
+		{"redis.password", &s.Redis.Password, s.Redis.PasswordFile},
+		{"pagination.cursorSecret", &s.Pagination.CursorSecret, s.Pagination.CursorSecretFile},
+		{"admin.token", &s.Admin.Token, s.Admin.TokenFile},
+		{"auth.customerTokenSecret", &s.Auth.CustomerTokenSecret, s.Auth.CustomerTokenSecretFile},
+	}
+	for _, secret := range secrets {
+		if secret.file == "" {
+			continue
+		}
+		data, err := os.ReadFile(secret.file)
+		if err != nil {
+			return fmt.Errorf("read %s: %w", secret.key, err)
+		}
+		*secret.value = strings.TrimRight(string(data), "\r\n")
+	}
+	return nil
+}
+
+const redacted = "[REDACTED]"
+
+// Redacted returns a copy of the config with its secrets replaced.
+func (s Server) Redacted() Server {
+	for _, secret := range []*string{&s.DB.Password, &s.Redis.Password, &s.Pagination.CursorSecret, &s.Admin.Token, &s.Auth.CustomerTokenSecret} {
+		if *secret != "" {
+			*secret = redacted
+		}
+	}
+	replicas := make([]string, len(s.DB.Replicas))
+	for i, dsn := range s.DB.Replicas {
+		replicas[i] = redactURL(dsn)
+	}
+	s.DB.Replicas = replicas
+	if s.Log.Push.URL != "" {
+		s.Log.Push.URL = redactURL(s.Log.Push.URL)
+	}
+	return s
+}
+
+// redactURL replaces the password of the url u.
+func redactURL(u string) string {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return redacted
+	}
+	return parsed.Redacted()
+}
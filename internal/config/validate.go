@@ -0,0 +1,201 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strconv"
+
+	"github.com/rs/zerolog"
+)
+
+// reservationStrategies are the values of Booking.ReservationStrategy.
+var reservationStrategies = []string{"optimistic", "row_lock", "atomic", "redis"}
+
+// ErrInvalid lists every invalid key of a config.
+type ErrInvalid struct {
+	Errors []error
+}
+
+func (e ErrInvalid) Error() string {
+	msg := "invalid config:"
+	for _, err := range e.Errors {
+		msg += "\n  - " + err.Error()
+	}
+	return msg
+}
+
+func (e ErrInvalid) Unwrap() []error {
+	return e.Errors
+}
+
+type validator struct {
+	errs []error
+}
+
+func (v *validator) check(ok bool, key, format string, args ...any) {
+	if !ok {
+		v.errs = append(v.errs, fmt.Errorf("%s %s", key, fmt.Sprintf(format, args...)))
+	}
+}
+
+func (v *validator) port(key, port string) {
+	p, err := strconv.Atoi(port)
+	v.check(err == nil && p > 0 && p < 65536, key, "must be a port between 1 and 65535, got %q", port)
+}
+
+func (v *validator) oneOf(key, value string, values ...string) {
+	for _, s := range values {
+		if value == s {
+			return
+		}
+	}
+	v.check(false, key, "must be one of %v, got %q", values, value)
+}
+
+func (v *validator) tls(key string, t TLS) {
+	if !t.Enabled {
+		v.check(t.ClientCAFile == "", key+".clientCAFile", "requires %s.enabled", key)
+		return
+	}
+	v.check(t.CertFile != "", key+".certFile", "is required when TLS is enabled")
+	v.check(t.KeyFile != "", key+".keyFile", "is required when TLS is enabled")
+}
+
+func (v *validator) retry(key string, r Retry) {
+	v.check(r.MaxAttempts >= 0, key+".maxAttempts", "must not be negative, got %d", r.MaxAttempts)
+	v.check(r.InitialBackoffMs >= 0, key+".initialBackoffMs", "must not be negative, got %d", r.InitialBackoffMs)
+	v.check(r.MaxBackoffMs >= r.InitialBackoffMs, key+".maxBackoffMs", "must be at least %s.initialBackoffMs, got %d", key, r.MaxBackoffMs)
+}
+
+func (v *validator) levelSampling(key string, s LevelSampling) {
+	v.check(s.Burst >= 0 && s.PeriodMs >= 0 && s.Thereafter >= 0, key, "must not have negative values")
+	v.check(s.Burst == 0 || s.PeriodMs > 0, key+".periodMs", "must be positive when burst is set, got %d", s.PeriodMs)
+}
+
+func (v *validator) logPush(key string, p LogPush) {
+	v.oneOf(key+".type", p.Type, "", "loki", "otlp")
+	if p.Type == "" {
+		return
+	}
+	u, err := url.Parse(p.URL)
+	v.check(err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != "", key+".url", "must be an http:// or https:// url when %s.type is set", key)
+	v.check(p.BatchSize > 0, key+".batchSize", "must be positive, got %d", p.BatchSize)
+	v.check(p.FlushIntervalMs > 0, key+".flushIntervalMs", "must be positive, got %d", p.FlushIntervalMs)
+	v.check(p.BufferSize >= p.BatchSize, key+".bufferSize", "must be at least %s.batchSize, got %d", key, p.BufferSize)
+	v.check(p.TimeoutSec > 0, key+".timeoutSec", "must be positive, got %d", p.TimeoutSec)
+}
+
+// faultErrors and latencyDistributions are the values of Fault.Error and Latency.Distribution.
+var (
+	faultErrors          = []string{"", "internal", "unavailable", "db", "redis"}
+	latencyDistributions = []string{"", "fixed", "uniform", "normal", "exponential"}
+)
+
+// minAdminTokenLength keeps the admin token from being guessed.
+const minAdminTokenLength = 16
+
+// minCustomerTokenSecretLength keeps the customer tokens from being forged.
+const minCustomerTokenSecretLength = 32
+
+// Validate returns an ErrInvalid listing all invalid keys of c.
+func (c Chaos) Validate() error {
+	v := &validator{}
+	v.chaos("chaos", c)
+	return v.err()
+}
+
+func (v *validator) chaos(key string, c Chaos) {
+	for i, f := range c.Faults {
+		v.fault(fmt.Sprintf("%s.faults[%d]", key, i), f)
+	}
+}
+
+func (v *validator) fault(key string, f Fault) {
+	_, err := path.Match(f.Target, "")
+	v.check(f.Target != "" && err == nil, key+".target", "must be a method or a pattern, got %q", f.Target)
+	v.check(f.Probability >= 0 && f.Probability <= 1, key+".probability", "must be between 0 and 1, got %g", f.Probability)
+	v.oneOf(key+".error", f.Error, faultErrors...)
+	l := f.Latency
+	v.oneOf(key+".latency.distribution", l.Distribution, latencyDistributions...)
+	v.check(l.MinMs >= 0 && l.MeanMs >= 0 && l.StdDevMs >= 0, key+".latency", "must not have negative durations")
+	v.check(l.Distribution != "uniform" || l.MaxMs >= l.MinMs, key+".latency.maxMs", "must be at least minMs, got %d", l.MaxMs)
+	v.check(l.MaxMs == 0 || l.MaxMs >= l.MinMs, key+".latency.maxMs", "must be 0 or at least minMs, got %d", l.MaxMs)
+}
+
+func (v *validator) err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return ErrInvalid{Errors: v.errs}
+}
+
+// Validate returns an ErrInvalid listing all invalid keys.
+func (s Server) Validate() error {
+	v := &validator{}
+	v.port("grpc.port", s.GRPC.Port)
+	v.port("http.port", s.HTTP.Port)
+	v.check(s.SinglePort || s.GRPC.Addr() != s.HTTP.Addr(), "http.port", "must differ from grpc.port unless singlePort is set")
+	v.tls("grpc.tls", s.GRPC.TLS)
+	v.tls("http.tls", s.HTTP.TLS)
+	v.check(!s.SinglePort || !s.GRPC.TLS.Enabled, "grpc.tls.enabled", "must not be set with singlePort, grpc is served with http.tls")
+
+	_, err := zerolog.ParseLevel(s.Log.Level)
+	v.check(err == nil && s.Log.Level != "", "log.level", "must be one of trace, debug, info, warn, error, fatal or panic, got %q", s.Log.Level)
+	v.oneOf("log.type", s.Log.Type, "json", "text")
+	v.check(!s.Log.LogFileEnabled || s.Log.LogFilePath != "", "log.logFilePath", "is required when log.logFileEnabled is set")
+	r := s.Log.Rotation
+	v.check(r.MaxSizeMB >= 0 && r.IntervalHours >= 0 && r.MaxBackups >= 0 && r.MaxAgeDays >= 0, "log.rotation", "must not have negative values")
+	v.levelSampling("log.sampling.trace", s.Log.Sampling.Trace)
+	v.levelSampling("log.sampling.debug", s.Log.Sampling.Debug)
+	v.levelSampling("log.sampling.info", s.Log.Sampling.Info)
+	v.logPush("log.push", s.Log.Push)
+
+	v.check(s.DB.Host != "", "db.host", "is required")
+	v.port("db.port", s.DB.Port)
+	v.check(s.DB.Name != "", "db.name", "is required")
+	v.check(s.DB.User != "", "db.user", "is required")
+	v.check(s.DB.MaxOpenConn > 0, "db.maxOpenConn", "must be positive, got %d", s.DB.MaxOpenConn)
+	v.check(s.DB.MaxIdleConn >= 0 && s.DB.MaxIdleConn <= s.DB.MaxOpenConn, "db.maxIdleConn", "must be between 0 and db.maxOpenConn, got %d", s.DB.MaxIdleConn)
+	for i, dsn := range s.DB.Replicas {
+		u, err := url.Parse(dsn)
+		v.check(err == nil && (u.Scheme == "postgres" || u.Scheme == "postgresql") && u.Host != "",
+			fmt.Sprintf("db.replicas[%d]", i), "must be a postgres:// url")
+	}
+	v.check(len(s.DB.Replicas) == 0 || s.DB.ReplicaCheckIntervalSec > 0, "db.replicaCheckIntervalSec", "must be positive when there are replicas, got %d", s.DB.ReplicaCheckIntervalSec)
+	v.check(s.DB.ReplicaMaxLagSec >= 0, "db.replicaMaxLagSec", "must not be negative, got %d", s.DB.ReplicaMaxLagSec)
+	v.retry("db.connectRetry", s.DB.ConnectRetry)
+	v.retry("db.transactionRetry", s.DB.TransactionRetry)
+	v.retry("db.readRetry", s.DB.ReadRetry)
+
+	v.check(s.Redis.Host != "", "redis.host", "is required")
+	v.port("redis.port", s.Redis.Port)
+	v.check(s.Redis.DB >= 0, "redis.db", "must not be negative, got %d", s.Redis.DB)
+	v.check(s.Redis.ConnDialTimeoutSec >= 0, "redis.connDialTimeoutSec", "must not be negative, got %d", s.Redis.ConnDialTimeoutSec)
+	v.check(s.Redis.ReadTimeoutSec >= -2, "redis.readTimeoutSec", "must be at least -2, got %d", s.Redis.ReadTimeoutSec)
+	v.check(s.Redis.WriteTimeoutSec >= -2, "redis.writeTimeoutSec", "must be at least -2, got %d", s.Redis.WriteTimeoutSec)
+	v.check(s.Redis.ConnPoolSize >= 0, "redis.connPoolSize", "must not be negative, got %d", s.Redis.ConnPoolSize)
+	v.check(s.Redis.ConnPoolTimeoutSec >= 0, "redis.connPoolTimeoutSec", "must not be negative, got %d", s.Redis.ConnPoolTimeoutSec)
+	v.check(s.Redis.MinIdleConn >= 0, "redis.minIdleConn", "must not be negative, got %d", s.Redis.MinIdleConn)
+	v.check(s.Redis.MaxIdleConn >= 0, "redis.maxIdleConn", "must not be negative, got %d", s.Redis.MaxIdleConn)
+	v.retry("redis.connectRetry", s.Redis.ConnectRetry)
+	v.check(s.Redis.Breaker.FailureThreshold > 0, "redis.breaker.failureThreshold", "must be positive, got %d", s.Redis.Breaker.FailureThreshold)
+	v.check(s.Redis.Breaker.OpenSec > 0, "redis.breaker.openSec", "must be positive, got %d", s.Redis.Breaker.OpenSec)
+
+	v.oneOf("booking.reservationStrategy", s.Booking.ReservationStrategy, reservationStrategies...)
+	v.check(s.Booking.HoldDurationSec > 0, "booking.holdDurationSec", "must be positive, got %d", s.Booking.HoldDurationSec)
+	v.check(s.Booking.MaxReservationRetries >= 0, "booking.maxReservationRetries", "must not be negative, got %d", s.Booking.MaxReservationRetries)
+	v.check(s.Booking.MaxReleaseRetries >= 0, "booking.maxReleaseRetries", "must not be negative, got %d", s.Booking.MaxReleaseRetries)
+
+	v.check(!s.RateLimit.Enabled || s.RateLimit.RequestsPerSec > 0, "rateLimit.requestsPerSec", "must be positive when the rate limit is enabled, got %g", s.RateLimit.RequestsPerSec)
+	v.check(!s.RateLimit.Enabled || s.RateLimit.Burst > 0, "rateLimit.burst", "must be positive when the rate limit is enabled, got %d", s.RateLimit.Burst)
+
+	v.check(!s.Reconcile.Enabled || s.Reconcile.IntervalSec > 0, "reconcile.intervalSec", "must be positive when reconcile is enabled, got %d", s.Reconcile.IntervalSec)
+	v.check(!s.Scheduler.Enabled || s.Scheduler.IntervalSec > 0, "scheduler.intervalSec", "must be positive when the scheduler is enabled, got %d", s.Scheduler.IntervalSec)
+	v.chaos("chaos", s.Chaos)
+	v.check((!s.Admin.Enabled && s.Admin.Token == "") || len(s.Admin.Token) >= minAdminTokenLength, "admin.token",
+		"must have at least %d characters when it is set or the admin API is enabled", minAdminTokenLength)
+	v.check(s.Auth.CustomerTokenSecret == "" || len(s.Auth.CustomerTokenSecret) >= minCustomerTokenSecretLength, "auth.customerTokenSecret",
+		"must have at least %d characters when it is set", minCustomerTokenSecretLength)
+	return v.err()
+}
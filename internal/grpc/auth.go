@@ -0,0 +1,81 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/imrenagicom/demo-app/internal/audit"
+	"github.com/imrenagicom/demo-app/internal/auth"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// ActorMetadataKey names the admin in the audit trail. It is only read for
+// callers holding the admin token.
+const ActorMetadataKey = "x-actor"
+
+const defaultAdminActor = "admin"
+
+// UnaryServerAuthInterceptor authenticates the caller from the authorization
+// metadata and rejects the callers of adminMethods which do not hold the
+// admin token. The actor of the audit trail is set from the authenticated
+// caller only.
+func UnaryServerAuthInterceptor(authn *auth.Authenticator, adminMethods map[string]bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authenticate(ctx, authn, adminMethods[info.FullMethod])
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerAuthInterceptor is UnaryServerAuthInterceptor for streams.
+func StreamServerAuthInterceptor(authn *auth.Authenticator, adminMethods map[string]bool) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), authn, adminMethods[info.FullMethod])
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func authenticate(ctx context.Context, authn *auth.Authenticator, adminOnly bool) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	p, err := authn.Authenticate(firstValue(md, "authorization"))
+	if err != nil {
+		return nil, err
+	}
+	ctx = auth.WithPrincipal(ctx, p)
+	if adminOnly {
+		if err := auth.RequireAdmin(ctx); err != nil {
+			return nil, err
+		}
+	}
+	admin := firstValue(md, ActorMetadataKey)
+	if admin == "" {
+		admin = defaultAdminActor
+	}
+	if actor := p.Actor(admin); actor != "" {
+		ctx = audit.WithActor(ctx, actor)
+	}
+	return ctx, nil
+}
+
+func firstValue(md metadata.MD, key string) string {
+	if v := md.Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+// contextStream is a stream with the context of an interceptor.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,73 @@
+package grpc
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/imrenagicom/demo-app/internal/chaos"
+	"github.com/imrenagicom/demo-app/internal/config"
+
+	"google.golang.org/grpc"
+)
+
+// UnaryServerChaosInterceptor injects the faults of i whose target is the
+// full gRPC method, such as
+// /imrenagicom.demoapp.course.v1.BookingService/ReserveBooking, before the
+// method is called.
+func UnaryServerChaosInterceptor(i *chaos.Injector) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := i.Inject(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerChaosInterceptor is UnaryServerChaosInterceptor for streams.
+func StreamServerChaosInterceptor(i *chaos.Injector) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := i.Inject(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// FullMethods returns the full gRPC methods of the services, such as
+// /imrenagicom.demoapp.course.v1.BookingService/ReserveBooking.
+func FullMethods(services ...grpc.ServiceDesc) []string {
+	var methods []string
+	for _, sd := range services {
+		for _, m := range sd.Methods {
+			methods = append(methods, "/"+sd.ServiceName+"/"+m.MethodName)
+		}
+		for _, st := range sd.Streams {
+			methods = append(methods, "/"+sd.ServiceName+"/"+st.StreamName)
+		}
+	}
+	return methods
+}
+
+// UnmatchedFaultTargets returns the targets of the faults of c which name a
+// gRPC method, as they start with a slash, but match none of methods. Such
+// faults are never injected, usually because of a wrong package name.
+func UnmatchedFaultTargets(c config.Chaos, methods []string) []string {
+	var unmatched []string
+	for _, f := range c.Faults {
+		if !strings.HasPrefix(f.Target, "/") {
+			continue
+		}
+		matched := false
+		for _, m := range methods {
+			if ok, _ := path.Match(f.Target, m); ok {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			unmatched = append(unmatched, f.Target)
+		}
+	}
+	return unmatched
+}
@@ -0,0 +1,26 @@
+package grpc
+
+import (
+	"testing"
+
+	"github.com/imrenagicom/demo-app/internal/config"
+)
+
+func TestUnmatchedFaultTargets(t *testing.T) {
+	methods := []string{
+		"/imrenagicom.demoapp.course.v1.BookingService/ReserveBooking",
+		"/imrenagicom.demoapp.course.v1.CatalogService/GetCourse",
+	}
+	c := config.Chaos{Faults: []config.Fault{
+		{Target: "booking.Store.FindBookingByID"},
+		{Target: "/imrenagicom.demoapp.course.v1.BookingService/ReserveBooking"},
+		{Target: "/imrenagicom.demoapp.course.v1.CatalogService/*"},
+		{Target: "/course.v1.CatalogService/*"},
+		{Target: "/imrenagicom.demoapp.course.v1.BookingService/Reserve"},
+	}}
+	got := UnmatchedFaultTargets(c, methods)
+	want := []string{"/course.v1.CatalogService/*", "/imrenagicom.demoapp.course.v1.BookingService/Reserve"}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Fatalf("UnmatchedFaultTargets = %v, want %v", got, want)
+	}
+}
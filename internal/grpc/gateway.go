@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"net/textproto"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
@@ -16,3 +17,12 @@ func MustRegisterGWHandler(ctx context.Context, register RegisterFunc, mux *runt
 		panic(err)
 	}
 }
+
+// GatewayHeaderMatcher forwards the X-Actor header to the services as the
+// x-actor metadata, in addition to the headers of runtime.DefaultHeaderMatcher.
+func GatewayHeaderMatcher(key string) (string, bool) {
+	if textproto.CanonicalMIMEHeaderKey(key) == "X-Actor" {
+		return ActorMetadataKey, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
@@ -0,0 +1,218 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// MethodResolver finds the gRPC method the gateway calls for an HTTP request,
+// from the google.api.http rules of the services.
+type MethodResolver struct {
+	mux *runtime.ServeMux
+}
+
+type methodKey struct{}
+
+// NewMethodResolver returns the resolver of the unary methods of services,
+// such as those returned by grpc.Server.GetServiceInfo.
+func NewMethodResolver(services map[string]grpc.ServiceInfo) (*MethodResolver, error) {
+	mux := runtime.NewServeMux(runtime.WithDisablePathLengthFallback())
+	for name, info := range services {
+		d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name))
+		if err != nil {
+			return nil, err
+		}
+		sd, ok := d.(protoreflect.ServiceDescriptor)
+		if !ok {
+			return nil, fmt.Errorf("%s is not a service", name)
+		}
+		for _, m := range info.Methods {
+			if m.IsClientStream || m.IsServerStream {
+				continue
+			}
+			md := sd.Methods().ByName(protoreflect.Name(m.Name))
+			if md == nil {
+				return nil, fmt.Errorf("method %s of %s not found", m.Name, name)
+			}
+			rule, _ := proto.GetExtension(md.Options(), annotations.E_Http).(*annotations.HttpRule)
+			if rule == nil {
+				continue
+			}
+			fullMethod := "/" + name + "/" + m.Name
+			for _, r := range append([]*annotations.HttpRule{rule}, rule.GetAdditionalBindings()...) {
+				verb, path := httpRulePattern(r)
+				if path == "" {
+					continue
+				}
+				err := mux.HandlePath(verb, path, func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+					*r.Context().Value(methodKey{}).(*string) = fullMethod
+				})
+				if err != nil {
+					return nil, fmt.Errorf("http rule of %s: %w", fullMethod, err)
+				}
+			}
+		}
+	}
+	return &MethodResolver{mux: mux}, nil
+}
+
+func httpRulePattern(r *annotations.HttpRule) (string, string) {
+	switch p := r.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		return http.MethodGet, p.Get
+	case *annotations.HttpRule_Put:
+		return http.MethodPut, p.Put
+	case *annotations.HttpRule_Post:
+		return http.MethodPost, p.Post
+	case *annotations.HttpRule_Delete:
+		return http.MethodDelete, p.Delete
+	case *annotations.HttpRule_Patch:
+		return http.MethodPatch, p.Patch
+	case *annotations.HttpRule_Custom:
+		return p.Custom.GetKind(), p.Custom.GetPath()
+	}
+	return "", ""
+}
+
+// Method returns the full gRPC method of r, such as
+// /imrenagicom.demoapp.course.v1.CatalogService/GetCourse, or an empty
+// string when r matches no method.
+func (m *MethodResolver) Method(r *http.Request) string {
+	var method string
+	m.mux.ServeHTTP(discardResponse{}, r.WithContext(context.WithValue(r.Context(), methodKey{}, &method)))
+	return method
+}
+
+type discardResponse struct{}
+
+func (discardResponse) Header() http.Header         { return http.Header{} }
+func (discardResponse) Write(p []byte) (int, error) { return len(p), nil }
+func (discardResponse) WriteHeader(int)             {}
+
+type errorSlotKey struct{}
+
+// GatewayErrorHandler is runtime.DefaultHTTPErrorHandler which also hands the
+// error to InterceptHTTP, so that interceptors see the error of the service
+// rather than an HTTP response. It writes the redirects of ForwardRedirect,
+// which are not errors of the service.
+func GatewayErrorHandler(ctx context.Context, mux *runtime.ServeMux, m runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	if writeRedirect(w, err) {
+		return
+	}
+	if slot, ok := r.Context().Value(errorSlotKey{}).(*error); ok {
+		*slot = err
+	}
+	runtime.DefaultHTTPErrorHandler(ctx, mux, m, w, r, err)
+}
+
+// InterceptHTTP runs interceptors around the requests of the unary methods
+// served by an in-process gateway, which calls the services directly rather
+// than through the gRPC server and its interceptors. gwmux must use
+// GatewayErrorHandler. Responses are buffered until the interceptors return,
+// so that a method can be run again, such as by the retry interceptor.
+func InterceptHTTP(gwmux *runtime.ServeMux, methods *MethodResolver, interceptors ...grpc.UnaryServerInterceptor) func(http.Handler) http.Handler {
+	intercept := chainUnaryInterceptors(interceptors)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			method := methods.Method(r)
+			if method == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			_, outbound := runtime.MarshalerForRequest(gwmux, r)
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				runtime.HTTPError(r.Context(), gwmux, outbound, w, r, status.Errorf(codes.InvalidArgument, "unable to read body: %v", err))
+				return
+			}
+
+			// the interceptors read the metadata the gateway passes to the method.
+			ctx, err := runtime.AnnotateIncomingContext(r.Context(), gwmux, r, method)
+			if err != nil {
+				runtime.HTTPError(r.Context(), gwmux, outbound, w, r, status.Error(codes.InvalidArgument, err.Error()))
+				return
+			}
+
+			var last *bufferedResponse
+			var lastErr error
+			_, err = intercept(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, _ interface{}) (interface{}, error) {
+				last, lastErr = &bufferedResponse{header: http.Header{}}, nil
+				req := r.WithContext(context.WithValue(ctx, errorSlotKey{}, &lastErr))
+				req.Body = io.NopCloser(bytes.NewReader(body))
+				next.ServeHTTP(last, req)
+				return nil, lastErr
+			})
+			// the response of the last run is written when the interceptors
+			// return its outcome, otherwise they failed on their own.
+			handled := last != nil && (lastErr == nil && err == nil || lastErr != nil && errors.Is(err, lastErr))
+			if handled {
+				last.writeTo(w)
+				return
+			}
+			if err == nil {
+				err = status.Error(codes.Internal, "request was not handled")
+			}
+			runtime.HTTPError(r.Context(), gwmux, outbound, w, r, err)
+		})
+	}
+}
+
+// chainUnaryInterceptors returns the interceptor running interceptors in
+// order, as grpc.ChainUnaryInterceptor does.
+func chainUnaryInterceptors(interceptors []grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], handler
+			handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, next)
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// bufferedResponse keeps a response until it is written to the client.
+type bufferedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (b *bufferedResponse) Header() http.Header { return b.header }
+
+func (b *bufferedResponse) Write(p []byte) (int, error) {
+	if b.status == 0 {
+		b.status = http.StatusOK
+	}
+	return b.body.Write(p)
+}
+
+func (b *bufferedResponse) WriteHeader(status int) {
+	if b.status == 0 {
+		b.status = status
+	}
+}
+
+func (b *bufferedResponse) writeTo(w http.ResponseWriter) {
+	for k, v := range b.header {
+		w.Header()[k] = v
+	}
+	if b.status == 0 {
+		b.status = http.StatusOK
+	}
+	w.WriteHeader(b.status)
+	w.Write(b.body.Bytes())
+}
@@ -10,9 +10,11 @@ import (
 	"google.golang.org/grpc"
 )
 
+// Logger returns logging.Logger backed by zerolog. Request and response payloads
+// are redacted before they are written, see Redact.
 func Logger() logging.Logger {
 	return logging.LoggerFunc(func(ctx context.Context, lvl logging.Level, msg string, fields ...any) {
-		l := log.Ctx(ctx).With().Fields(fields).Logger()
+		l := log.Ctx(ctx).With().Fields(redactFields(fields)).Logger()
 		switch lvl {
 		case logging.LevelDebug:
 			l.Debug().Msg(msg)
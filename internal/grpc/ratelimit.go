@@ -0,0 +1,33 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/imrenagicom/demo-app/internal/ratelimit"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerRateLimitInterceptor rejects requests with RESOURCE_EXHAUSTED
+// when limiter has no token left. Requests are not limited when enabled returns false.
+func UnaryServerRateLimitInterceptor(limiter *ratelimit.Limiter, enabled func() bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if enabled() && !limiter.Allow() {
+			return nil, status.Errorf(codes.ResourceExhausted, "rate limit exceeded, retry later")
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerRateLimitInterceptor is UnaryServerRateLimitInterceptor for streams.
+// A stream takes a single token when it starts.
+func StreamServerRateLimitInterceptor(limiter *ratelimit.Limiter, enabled func() bool) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if enabled() && !limiter.Allow() {
+			return status.Errorf(codes.ResourceExhausted, "rate limit exceeded, retry later")
+		}
+		return handler(srv, ss)
+	}
+}
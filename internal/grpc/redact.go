@@ -0,0 +1,73 @@
+package grpc
+
+import (
+	"github.com/imrenagicom/demo-app/pkg/apiclient"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const redactedValue = "[REDACTED]"
+
+// Redact returns a copy of msg where all fields annotated with
+// (imrenagicom.demoapp.sensitive) are masked. Nested messages, repeated
+// fields and maps are inspected as well. msg itself is never modified.
+func Redact(msg proto.Message) proto.Message {
+	if msg == nil {
+		return nil
+	}
+	m := proto.Clone(msg)
+	redactMessage(m.ProtoReflect())
+	return m
+}
+
+func redactMessage(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if isSensitive(fd) {
+			if fd.Kind() == protoreflect.StringKind && !fd.IsList() && !fd.IsMap() {
+				m.Set(fd, protoreflect.ValueOfString(redactedValue))
+			} else {
+				m.Clear(fd)
+			}
+			return true
+		}
+
+		switch {
+		case fd.IsList() && fd.Message() != nil:
+			l := v.List()
+			for i := 0; i < l.Len(); i++ {
+				redactMessage(l.Get(i).Message())
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				redactMessage(mv.Message())
+				return true
+			})
+		case !fd.IsList() && !fd.IsMap() && fd.Message() != nil:
+			redactMessage(v.Message())
+		}
+		return true
+	})
+}
+
+func isSensitive(fd protoreflect.FieldDescriptor) bool {
+	opts, ok := fd.Options().(*descriptorpb.FieldOptions)
+	if !ok || opts == nil {
+		return false
+	}
+	return proto.GetExtension(opts, apiclient.E_Sensitive).(bool)
+}
+
+// redactFields masks sensitive data of every proto message found in the
+// key-value pairs given to the logger.
+func redactFields(fields []any) []any {
+	redacted := make([]any, len(fields))
+	for i, f := range fields {
+		if m, ok := f.(proto.Message); ok {
+			f = Redact(m)
+		}
+		redacted[i] = f
+	}
+	return redacted
+}
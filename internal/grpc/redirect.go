@@ -0,0 +1,63 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+const redirectHeader = "x-redirect-resource"
+
+// SetRedirect marks the response as being served for an outdated resource
+// name. gRPC clients get the response as is, while HTTP clients going through
+// the gateway are redirected to name, see ForwardRedirect.
+func SetRedirect(ctx context.Context, name string) error {
+	return grpc.SetHeader(ctx, metadata.Pairs(redirectHeader, name))
+}
+
+// redirectError stops the gateway from writing the response of a redirected
+// request. GatewayErrorHandler answers it with the redirect.
+type redirectError struct {
+	location string
+}
+
+func (e redirectError) Error() string {
+	return "moved permanently to " + e.location
+}
+
+// writeRedirect answers with a permanent redirect to the location of err,
+// false when err is not a redirect.
+func writeRedirect(w http.ResponseWriter, err error) bool {
+	var redirect redirectError
+	if !errors.As(err, &redirect) {
+		return false
+	}
+	w.Header().Del("Content-Type")
+	w.Header().Set("Location", redirect.location)
+	w.WriteHeader(http.StatusMovedPermanently)
+	return true
+}
+
+// ForwardRedirect returns a gateway forward response option answering with a
+// permanent redirect to prefix joined with the resource name set by SetRedirect.
+// The gateway must use GatewayErrorHandler, which writes the redirect in place
+// of the response.
+func ForwardRedirect(prefix string) func(context.Context, http.ResponseWriter, proto.Message) error {
+	return func(ctx context.Context, w http.ResponseWriter, _ proto.Message) error {
+		md, ok := runtime.ServerMetadataFromContext(ctx)
+		if !ok {
+			return nil
+		}
+		names := md.HeaderMD.Get(redirectHeader)
+		if len(names) == 0 {
+			return nil
+		}
+		return redirectError{location: strings.TrimSuffix(prefix, "/") + "/" + strings.TrimPrefix(names[0], "/")}
+	}
+}
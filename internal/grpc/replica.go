@@ -0,0 +1,57 @@
+package grpc
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/imrenagicom/demo-app/internal/postgres"
+
+	"google.golang.org/grpc"
+)
+
+// readOnlyPrefixes are the prefixes of the methods which never write.
+var readOnlyPrefixes = []string{"Get", "List", "Search", "Export"}
+
+// IsReadOnlyMethod reports whether the gRPC method, such as
+// /imrenagicom.demoapp.course.v1.CatalogService/GetCourse, only reads.
+func IsReadOnlyMethod(fullMethod string) bool {
+	name := path.Base(fullMethod)
+	for _, prefix := range readOnlyPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// UnaryServerReplicaInterceptor pins the reads of the methods which write to
+// the primary, so that they read their own writes. The reads of read-only
+// methods may go to a replica.
+func UnaryServerReplicaInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !IsReadOnlyMethod(info.FullMethod) {
+			ctx = postgres.WithPrimary(ctx)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerReplicaInterceptor is UnaryServerReplicaInterceptor for streams.
+func StreamServerReplicaInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !IsReadOnlyMethod(info.FullMethod) {
+			ss = &primaryStream{ServerStream: ss, ctx: postgres.WithPrimary(ss.Context())}
+		}
+		return handler(srv, ss)
+	}
+}
+
+type primaryStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *primaryStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,30 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/imrenagicom/demo-app/internal/retry"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+)
+
+// UnaryServerRetryInterceptor runs read-only methods again when they fail
+// with an error for which retryable returns true, following policy. Methods
+// which write are never retried here, as they may have partly succeeded.
+func UnaryServerRetryInterceptor(policy retry.Policy, retryable func(error) bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !IsReadOnlyMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		var resp interface{}
+		err := retry.Do(ctx, policy, retryable, func(ctx context.Context) (err error) {
+			resp, err = handler(ctx, req)
+			return err
+		}, func(attempt int, err error, backoff time.Duration) {
+			log.Ctx(ctx).Warn().Err(err).Str("method", info.FullMethod).Int("attempt", attempt).Dur("backoff", backoff).Msg("retrying read")
+		})
+		return resp, err
+	}
+}
@@ -10,40 +10,105 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
+// SetLogLevel changes the minimum level of logged events.
+func SetLogLevel(level string) error {
+	l, err := zerolog.ParseLevel(level)
+	if err != nil {
+		return err
+	}
+	zerolog.SetGlobalLevel(l)
+	return nil
+}
+
+// InitializeLogger writes the events to stdout, and to the log file and the
+// push endpoint when they are configured. The returned func sends the pending
+// events and closes the sinks.
 func InitializeLogger(conf config.Logging) func() {
-	level, err := zerolog.ParseLevel(conf.Level)
+	err := SetLogLevel(conf.Level)
 	if err != nil {
 		log.Fatal().Err(err).Msg("unable to parse log level")
 	}
-	zerolog.SetGlobalLevel(level)
 
 	var stdOut io.Writer = os.Stdout
 	if conf.Type == "text" {
 		stdOut = zerolog.ConsoleWriter{Out: os.Stdout}
 	}
 	writers := []io.Writer{stdOut}
-	var runLogFile *os.File
+	var runLogFile *rotatingFile
 	if conf.LogFileEnabled {
-		runLogFile, err = os.OpenFile(
-			conf.LogFilePath,
-			os.O_APPEND|os.O_CREATE|os.O_WRONLY,
-			0666,
-		)
+		runLogFile, err = openRotatingFile(conf.LogFilePath, conf.Rotation)
 		if err != nil {
 			log.Fatal().Err(err).Msg("unable to open log file")
 		}
 
 		writers = append(writers, runLogFile)
 	}
+	var push *pushWriter
+	if conf.Push.Type != "" {
+		push = newPushWriter(conf.Push)
+		writers = append(writers, push)
+	}
 
 	zerolog.TimeFieldFormat = time.RFC3339Nano
 
-	multi := zerolog.MultiLevelWriter(writers...)
-	log.Logger = zerolog.New(multi).With().Timestamp().Logger()
+	flush := func() {
+		if push != nil {
+			push.Flush()
+		}
+		if runLogFile != nil {
+			runLogFile.Sync()
+		}
+	}
+	multi := fatalFlushWriter{LevelWriter: zerolog.MultiLevelWriter(writers...), flush: flush}
+	logger := zerolog.New(multi).With().Timestamp().Logger()
+	if conf.Sampling.Enabled {
+		logger = logger.Sample(zerolog.LevelSampler{
+			TraceSampler: levelSampler(conf.Sampling.Trace),
+			DebugSampler: levelSampler(conf.Sampling.Debug),
+			InfoSampler:  levelSampler(conf.Sampling.Info),
+		})
+	}
+	log.Logger = logger
 
 	return func() {
+		if push != nil {
+			push.Close()
+		}
 		if runLogFile != nil {
 			runLogFile.Close()
 		}
 	}
 }
+
+// levelSampler returns the sampler of a level, nil when every event of the
+// level is logged.
+func levelSampler(s config.LevelSampling) zerolog.Sampler {
+	var thereafter zerolog.Sampler
+	if s.Thereafter > 0 {
+		thereafter = &zerolog.BasicSampler{N: uint32(s.Thereafter)}
+	}
+	if s.Burst == 0 {
+		return thereafter
+	}
+	return &zerolog.BurstSampler{
+		Burst:       uint32(s.Burst),
+		Period:      time.Duration(s.PeriodMs) * time.Millisecond,
+		NextSampler: thereafter,
+	}
+}
+
+// fatalFlushWriter flushes the sinks after writing fatal and panic events,
+// because log.Fatal exits right after writing its event, before the cleanup
+// of InitializeLogger would run.
+type fatalFlushWriter struct {
+	zerolog.LevelWriter
+	flush func()
+}
+
+func (w fatalFlushWriter) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	n, err := w.LevelWriter.WriteLevel(level, p)
+	if level == zerolog.FatalLevel || level == zerolog.PanicLevel {
+		w.flush()
+	}
+	return n, err
+}
@@ -0,0 +1,296 @@
+package instrumentation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/imrenagicom/demo-app/internal/config"
+	"github.com/rs/zerolog"
+)
+
+// pushEntry is an event waiting to be pushed.
+type pushEntry struct {
+	time  time.Time
+	level zerolog.Level
+	line  string
+}
+
+// pushEncoder returns the request body pushing entries.
+type pushEncoder func(entries []pushEntry) ([]byte, error)
+
+// pushWriter sends the events to an HTTP endpoint in batches, from a single
+// goroutine. Writes never block: events are dropped when the buffer is full.
+// Failures are reported to stderr, as logging them would feed them back.
+type pushWriter struct {
+	client    *http.Client
+	url       string
+	encode    pushEncoder
+	batchSize int
+	interval  time.Duration
+
+	entries   chan pushEntry
+	flushes   chan chan struct{}
+	closing   chan struct{}
+	stopped   chan struct{}
+	closeOnce sync.Once
+
+	dropped atomic.Int64
+	failing bool
+}
+
+func newPushWriter(c config.LogPush) *pushWriter {
+	encode := encodeLoki(c.Labels)
+	if c.Type == "otlp" {
+		encode = encodeOTLP(c.Labels)
+	}
+	w := &pushWriter{
+		client:    &http.Client{Timeout: time.Duration(c.TimeoutSec) * time.Second},
+		url:       c.URL,
+		encode:    encode,
+		batchSize: c.BatchSize,
+		interval:  time.Duration(c.FlushIntervalMs) * time.Millisecond,
+		entries:   make(chan pushEntry, c.BufferSize),
+		flushes:   make(chan chan struct{}),
+		closing:   make(chan struct{}),
+		stopped:   make(chan struct{}),
+	}
+	go w.run()
+	return w
+}
+
+func (w *pushWriter) Write(p []byte) (int, error) {
+	return w.WriteLevel(zerolog.NoLevel, p)
+}
+
+// WriteLevel queues the event. zerolog reuses p, so the event is copied.
+func (w *pushWriter) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	e := pushEntry{time: time.Now(), level: level, line: string(bytes.TrimRight(p, "\n"))}
+	select {
+	case w.entries <- e:
+	default:
+		w.dropped.Add(1)
+	}
+	return len(p), nil
+}
+
+func (w *pushWriter) run() {
+	defer close(w.stopped)
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	var batch []pushEntry
+	for {
+		select {
+		case e := <-w.entries:
+			batch = append(batch, e)
+			if len(batch) >= w.batchSize {
+				w.send(batch)
+				batch = nil
+			}
+		case <-ticker.C:
+			w.send(batch)
+			batch = nil
+		case done := <-w.flushes:
+			w.send(w.drain(batch))
+			batch = nil
+			close(done)
+		case <-w.closing:
+			w.send(w.drain(batch))
+			return
+		}
+	}
+}
+
+// drain appends the queued events to batch.
+func (w *pushWriter) drain(batch []pushEntry) []pushEntry {
+	for {
+		select {
+		case e := <-w.entries:
+			batch = append(batch, e)
+		default:
+			return batch
+		}
+	}
+}
+
+// send pushes entries in requests of at most batchSize events.
+func (w *pushWriter) send(entries []pushEntry) {
+	for len(entries) > 0 {
+		n := min(len(entries), w.batchSize)
+		w.report(w.push(entries[:n]), n)
+		entries = entries[n:]
+	}
+}
+
+func (w *pushWriter) push(entries []pushEntry) error {
+	body, err := w.encode(entries)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("%s: %s", resp.Status, bytes.TrimSpace(msg))
+	}
+	return nil
+}
+
+// report writes to stderr when pushing starts failing, recovers, or when
+// events were dropped, rather than on every request.
+func (w *pushWriter) report(err error, n int) {
+	if err != nil {
+		w.dropped.Add(int64(n))
+		if !w.failing {
+			fmt.Fprintf(os.Stderr, "unable to push logs to %s: %v\n", w.url, err)
+		}
+		w.failing = true
+		return
+	}
+	if w.failing {
+		fmt.Fprintf(os.Stderr, "pushing logs to %s again\n", w.url)
+	}
+	w.failing = false
+	if dropped := w.dropped.Swap(0); dropped > 0 {
+		fmt.Fprintf(os.Stderr, "dropped %d log events not pushed to %s\n", dropped, w.url)
+	}
+}
+
+// Flush sends the queued events and waits until they are sent.
+func (w *pushWriter) Flush() {
+	done := make(chan struct{})
+	select {
+	case w.flushes <- done:
+		<-done
+	case <-w.stopped:
+	}
+}
+
+// Close sends the queued events and stops the writer. Events written
+// afterwards are dropped.
+func (w *pushWriter) Close() error {
+	w.closeOnce.Do(func() {
+		close(w.closing)
+	})
+	<-w.stopped
+	return nil
+}
+
+// encodeLoki returns the body of the loki push API, with a stream for each
+// level. See https://grafana.com/docs/loki/latest/reference/api/#push-log-entries-to-loki.
+func encodeLoki(labels map[string]string) pushEncoder {
+	type stream struct {
+		Stream map[string]string `json:"stream"`
+		Values [][2]string       `json:"values"`
+	}
+	return func(entries []pushEntry) ([]byte, error) {
+		streams := map[zerolog.Level]*stream{}
+		var order []zerolog.Level
+		for _, e := range entries {
+			s, ok := streams[e.level]
+			if !ok {
+				s = &stream{Stream: map[string]string{}}
+				for k, v := range labels {
+					s.Stream[k] = v
+				}
+				if e.level != zerolog.NoLevel {
+					s.Stream["level"] = e.level.String()
+				}
+				streams[e.level] = s
+				order = append(order, e.level)
+			}
+			s.Values = append(s.Values, [2]string{strconv.FormatInt(e.time.UnixNano(), 10), e.line})
+		}
+		body := struct {
+			Streams []*stream `json:"streams"`
+		}{}
+		for _, l := range order {
+			body.Streams = append(body.Streams, streams[l])
+		}
+		return json.Marshal(body)
+	}
+}
+
+// encodeOTLP returns the body of an OTLP/HTTP logs export request in JSON,
+// with labels as the resource attributes and the event as the record body.
+// See https://opentelemetry.io/docs/specs/otlp/#otlphttp.
+func encodeOTLP(labels map[string]string) pushEncoder {
+	type anyValue struct {
+		StringValue string `json:"stringValue"`
+	}
+	type keyValue struct {
+		Key   string   `json:"key"`
+		Value anyValue `json:"value"`
+	}
+	type logRecord struct {
+		TimeUnixNano         string   `json:"timeUnixNano"`
+		ObservedTimeUnixNano string   `json:"observedTimeUnixNano"`
+		SeverityNumber       int      `json:"severityNumber,omitempty"`
+		SeverityText         string   `json:"severityText,omitempty"`
+		Body                 anyValue `json:"body"`
+	}
+	type scopeLogs struct {
+		Scope struct {
+			Name string `json:"name"`
+		} `json:"scope"`
+		LogRecords []logRecord `json:"logRecords"`
+	}
+	type resourceLogs struct {
+		Resource struct {
+			Attributes []keyValue `json:"attributes"`
+		} `json:"resource"`
+		ScopeLogs []scopeLogs `json:"scopeLogs"`
+	}
+
+	var resource resourceLogs
+	for k, v := range labels {
+		resource.Resource.Attributes = append(resource.Resource.Attributes, keyValue{Key: k, Value: anyValue{StringValue: v}})
+	}
+	return func(entries []pushEntry) ([]byte, error) {
+		scope := scopeLogs{LogRecords: make([]logRecord, 0, len(entries))}
+		scope.Scope.Name = "github.com/imrenagicom/demo-app"
+		for _, e := range entries {
+			ts := strconv.FormatInt(e.time.UnixNano(), 10)
+			r := logRecord{TimeUnixNano: ts, ObservedTimeUnixNano: ts, Body: anyValue{StringValue: e.line}}
+			if e.level != zerolog.NoLevel {
+				r.SeverityNumber = otlpSeverities[e.level]
+				r.SeverityText = strings.ToUpper(e.level.String())
+			}
+			scope.LogRecords = append(scope.LogRecords, r)
+		}
+		r := resource
+		r.ScopeLogs = []scopeLogs{scope}
+		return json.Marshal(struct {
+			ResourceLogs []resourceLogs `json:"resourceLogs"`
+		}{ResourceLogs: []resourceLogs{r}})
+	}
+}
+
+// otlpSeverities are the OTLP severity numbers of the zerolog levels.
+var otlpSeverities = map[zerolog.Level]int{
+	zerolog.TraceLevel: 1,
+	zerolog.DebugLevel: 5,
+	zerolog.InfoLevel:  9,
+	zerolog.WarnLevel:  13,
+	zerolog.ErrorLevel: 17,
+	zerolog.FatalLevel: 21,
+	zerolog.PanicLevel: 24,
+}
@@ -0,0 +1,176 @@
+package instrumentation
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/imrenagicom/demo-app/internal/config"
+)
+
+// backupTimeFormat is the rotation time in the name of rotated files. It has
+// no colons, which are not allowed in file names on every system.
+const backupTimeFormat = "2006-01-02T15-04-05.000"
+
+// rotatingFile is a log file which is renamed and replaced by a new file when
+// it grows past maxSize or when a new interval begins. Rotated files beyond
+// maxBackups or older than maxAge are removed.
+type rotatingFile struct {
+	path       string
+	maxSize    int64
+	interval   time.Duration
+	maxBackups int
+	maxAge     time.Duration
+
+	mu     sync.Mutex
+	file   *os.File
+	size   int64
+	period time.Time
+}
+
+func openRotatingFile(path string, c config.LogRotation) (*rotatingFile, error) {
+	f := &rotatingFile{
+		path:       path,
+		maxSize:    int64(c.MaxSizeMB) * 1024 * 1024,
+		interval:   time.Duration(c.IntervalHours) * time.Hour,
+		maxBackups: c.MaxBackups,
+		maxAge:     time.Duration(c.MaxAgeDays) * 24 * time.Hour,
+	}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	f.prune()
+	return f, nil
+}
+
+// open opens the file for appending. The interval of an existing file is the
+// one it was last written in, so that a restart does not postpone rotation.
+func (f *rotatingFile) open() error {
+	file, err := os.OpenFile(f.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0666)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	f.file = file
+	f.size = info.Size()
+	f.period = f.periodOf(info.ModTime())
+	if info.Size() == 0 {
+		f.period = f.periodOf(time.Now())
+	}
+	return nil
+}
+
+func (f *rotatingFile) periodOf(t time.Time) time.Time {
+	if f.interval <= 0 {
+		return time.Time{}
+	}
+	return t.UTC().Truncate(f.interval)
+}
+
+func (f *rotatingFile) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := time.Now()
+	sizeExceeded := f.maxSize > 0 && f.size > 0 && f.size+int64(len(p)) > f.maxSize
+	if sizeExceeded || f.periodOf(now) != f.period {
+		// the event is still written to the current file when rotation fails.
+		if err := f.rotate(now); err != nil {
+			fmt.Fprintf(os.Stderr, "unable to rotate log file %s: %v\n", f.path, err)
+		}
+	}
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+	return n, err
+}
+
+func (f *rotatingFile) rotate(now time.Time) error {
+	// the period is updated first, so that a failed rotation is not retried
+	// by every write of the interval.
+	f.period = f.periodOf(now)
+	if err := os.Rename(f.path, f.backupName(now)); err != nil {
+		return err
+	}
+	old := f.file
+	if err := f.open(); err != nil {
+		// keep writing to the renamed file rather than losing events.
+		return err
+	}
+	old.Close()
+	go f.prune()
+	return nil
+}
+
+// backupName returns the name of the file rotated at t, such as
+// logs/app-2024-01-02T15-04-05.000.log for logs/app.log.
+func (f *rotatingFile) backupName(t time.Time) string {
+	ext := filepath.Ext(f.path)
+	return strings.TrimSuffix(f.path, ext) + "-" + t.UTC().Format(backupTimeFormat) + ext
+}
+
+// prune removes the rotated files beyond maxBackups and older than maxAge.
+func (f *rotatingFile) prune() {
+	if f.maxBackups <= 0 && f.maxAge <= 0 {
+		return
+	}
+	dir := filepath.Dir(f.path)
+	ext := filepath.Ext(f.path)
+	prefix := strings.TrimSuffix(filepath.Base(f.path), ext) + "-"
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "unable to list rotated log files: %v\n", err)
+		return
+	}
+
+	type backup struct {
+		name string
+		time time.Time
+	}
+	var backups []backup
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, ext) {
+			continue
+		}
+		t, err := time.Parse(backupTimeFormat, strings.TrimSuffix(strings.TrimPrefix(name, prefix), ext))
+		if err != nil {
+			continue
+		}
+		backups = append(backups, backup{name: name, time: t})
+	}
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].time.After(backups[j].time)
+	})
+
+	for i, b := range backups {
+		tooMany := f.maxBackups > 0 && i >= f.maxBackups
+		tooOld := f.maxAge > 0 && time.Since(b.time) > f.maxAge
+		if !tooMany && !tooOld {
+			continue
+		}
+		if err := os.Remove(filepath.Join(dir, b.name)); err != nil && !os.IsNotExist(err) {
+			fmt.Fprintf(os.Stderr, "unable to remove rotated log file %s: %v\n", b.name, err)
+		}
+	}
+}
+
+// Sync commits the written events to disk.
+func (f *rotatingFile) Sync() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.file.Sync()
+}
+
+func (f *rotatingFile) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.file.Close()
+}
@@ -0,0 +1,162 @@
+package instrumentation
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/imrenagicom/demo-app/internal/config"
+)
+
+// backups returns the sorted names of the rotated files of app.log in dir.
+func backups(t *testing.T, dir string) []string {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, e := range entries {
+		if strings.HasPrefix(e.Name(), "app-") {
+			names = append(names, e.Name())
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func readFile(t *testing.T, name string) string {
+	t.Helper()
+	data, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestRotatingFileRotatesOnSize(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "app.log")
+	f, err := openRotatingFile(path, config.LogRotation{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	f.maxSize = 10
+
+	for _, event := range []string{"first\n", "second\n", "third\n"} {
+		if _, err := f.Write([]byte(event)); err != nil {
+			t.Fatal(err)
+		}
+		// rotated files are named after the millisecond of their rotation.
+		time.Sleep(2 * time.Millisecond)
+	}
+
+	names := backups(t, dir)
+	if len(names) != 2 {
+		t.Fatalf("rotated files = %v, want 2", names)
+	}
+	if got := readFile(t, filepath.Join(dir, names[0])); got != "first\n" {
+		t.Fatalf("first rotated file = %q, want %q", got, "first\n")
+	}
+	if got := readFile(t, filepath.Join(dir, names[1])); got != "second\n" {
+		t.Fatalf("second rotated file = %q, want %q", got, "second\n")
+	}
+	if got := readFile(t, path); got != "third\n" {
+		t.Fatalf("log file = %q, want %q", got, "third\n")
+	}
+}
+
+func TestRotatingFileRotatesOnInterval(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "app.log")
+	f, err := openRotatingFile(path, config.LogRotation{IntervalHours: 24})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	f.Write([]byte("today\n"))
+	if names := backups(t, dir); len(names) != 0 {
+		t.Fatalf("rotated files = %v, want none within the interval", names)
+	}
+
+	// the file was last written the day before.
+	f.period = f.period.Add(-24 * time.Hour)
+	f.Write([]byte("tomorrow\n"))
+	names := backups(t, dir)
+	if len(names) != 1 {
+		t.Fatalf("rotated files = %v, want 1", names)
+	}
+	if got := readFile(t, filepath.Join(dir, names[0])); got != "today\n" {
+		t.Fatalf("rotated file = %q, want %q", got, "today\n")
+	}
+	if got := readFile(t, path); got != "tomorrow\n" {
+		t.Fatalf("log file = %q, want %q", got, "tomorrow\n")
+	}
+}
+
+func TestRotatingFileKeepsIntervalOfExistingFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "app.log")
+	if err := os.WriteFile(path, []byte("yesterday\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	yesterday := time.Now().Add(-24 * time.Hour)
+	if err := os.Chtimes(path, yesterday, yesterday); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := openRotatingFile(path, config.LogRotation{IntervalHours: 24})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	f.Write([]byte("today\n"))
+
+	names := backups(t, dir)
+	if len(names) != 1 || readFile(t, filepath.Join(dir, names[0])) != "yesterday\n" {
+		t.Fatalf("rotated files = %v, want the file of yesterday", names)
+	}
+}
+
+func TestRotatingFilePrunes(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "app.log")
+	now := time.Now()
+	var names []string
+	for _, age := range []time.Duration{time.Hour, 2 * time.Hour, 3 * time.Hour, 10 * 24 * time.Hour} {
+		name := "app-" + now.Add(-age).UTC().Format(backupTimeFormat) + ".log"
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, name)
+	}
+	// files not named as rotated files are kept.
+	if err := os.WriteFile(filepath.Join(dir, "app-other.log"), nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := openRotatingFile(path, config.LogRotation{MaxBackups: 3, MaxAgeDays: 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	got := backups(t, dir)
+	want := []string{names[2], names[1], names[0], "app-other.log"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Fatalf("files after pruning by age = %v, want %v", got, want)
+	}
+
+	f.maxBackups = 2
+	f.prune()
+	got = backups(t, dir)
+	want = []string{names[1], names[0], "app-other.log"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Fatalf("files after pruning by count = %v, want %v", got, want)
+	}
+}
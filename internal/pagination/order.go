@@ -0,0 +1,78 @@
+package pagination
+
+import (
+	"fmt"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ErrInvalidOrderBy struct {
+	Message string
+}
+
+func (e ErrInvalidOrderBy) Error() string {
+	return e.Message
+}
+
+func (e ErrInvalidOrderBy) GRPCStatus() *status.Status {
+	return status.New(codes.InvalidArgument, e.Error())
+}
+
+// Order is a sort order on a single field, ties are broken by id.
+type Order struct {
+	Field string
+	Desc  bool
+}
+
+func (o Order) String() string {
+	if o.Desc {
+		return o.Field + " desc"
+	}
+	return o.Field + " asc"
+}
+
+// ParseOrder parses an AIP-160 style order_by of a single field, e.g.
+// "published_at desc". def is returned when orderBy is empty.
+func ParseOrder(orderBy string, def Order, allowed ...string) (Order, error) {
+	parts := strings.Fields(strings.ToLower(orderBy))
+	if len(parts) == 0 {
+		return def, nil
+	}
+
+	o := Order{Field: parts[0]}
+	switch {
+	case len(parts) == 1:
+	case len(parts) == 2 && parts[1] == "asc":
+	case len(parts) == 2 && parts[1] == "desc":
+		o.Desc = true
+	default:
+		return Order{}, ErrInvalidOrderBy{Message: fmt.Sprintf("invalid order_by %q", orderBy)}
+	}
+	for _, a := range allowed {
+		if a == o.Field {
+			return o, nil
+		}
+	}
+	return Order{}, ErrInvalidOrderBy{Message: fmt.Sprintf("can not order by %s, supported fields are %s", o.Field, strings.Join(allowed, ", "))}
+}
+
+// OrderBy returns the ORDER BY clauses sorting by key and then by id.
+func (o Order) OrderBy(key, id string) []string {
+	dir := " ASC"
+	if o.Desc {
+		dir = " DESC"
+	}
+	return []string{key + dir, id + dir}
+}
+
+// After returns the condition selecting the rows following the given sort key.
+func (o Order) After(key, id string, keyValue, idValue any) sq.Sqlizer {
+	op := ">"
+	if o.Desc {
+		op = "<"
+	}
+	return sq.Expr(fmt.Sprintf("(%s, %s) %s (?, ?)", key, id, op), keyValue, idValue)
+}
@@ -1,27 +1,271 @@
 package postgres
 
 import (
+	"context"
+	"database/sql"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres" // need this here for running migrate on testing.
-	_ "github.com/golang-migrate/migrate/v4/source/file"       // need this here for running migrate on testing.
+	"github.com/golang-migrate/migrate/v4/source"
+	_ "github.com/golang-migrate/migrate/v4/source/file" // need this here for running migrate on testing.
+	"github.com/rs/zerolog/log"
 )
 
-func Migrate(dir string, databaseUrl string, up bool) error {
+// migrationLockID is the key of the advisory lock held while migrating, so that
+// replicas starting at the same time migrate one after the other.
+const migrationLockID int64 = 7_271_806_235_421
+
+// DefaultMigrationLockTimeout is how long to wait for another migration to finish.
+const DefaultMigrationLockTimeout = 5 * time.Minute
+
+// ErrDirty is returned when the last migration failed halfway. The schema has
+// to be fixed by hand and the version set with Force before migrating again.
+type ErrDirty struct {
+	Version uint
+}
+
+func (e ErrDirty) Error() string {
+	return fmt.Sprintf("database is dirty at version %d: the migration failed halfway. "+
+		"Fix the schema by hand, then run `migrate force %d` if the migration was applied completely, "+
+		"or `migrate force <previous version>` if it was not", e.Version, e.Version)
+}
+
+type MigratorOption func(*Migrator)
+
+// WithMigrationLockTimeout sets how long to wait for the migration lock.
+func WithMigrationLockTimeout(d time.Duration) MigratorOption {
+	return func(m *Migrator) {
+		m.lockTimeout = d
+	}
+}
+
+func NewMigrator(dir string, databaseUrl string, opts ...MigratorOption) (*Migrator, error) {
 	m, err := migrate.New(fmt.Sprintf("file://%s", dir), databaseUrl)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	db, err := sql.Open("postgres", databaseUrl)
+	if err != nil {
+		m.Close()
+		return nil, err
+	}
+	migrator := &Migrator{
+		dir:         dir,
+		m:           m,
+		db:          db,
+		lockTimeout: DefaultMigrationLockTimeout,
 	}
-	if up {
-		err = m.Up()
-	} else {
-		err = m.Down()
+	for _, o := range opts {
+		o(migrator)
 	}
-	if err != nil && !errors.Is(err, migrate.ErrNoChange) {
+	return migrator, nil
+}
+
+// Migrator runs the migrations of a directory. Every change is done while
+// holding a session advisory lock, so concurrent migrators wait for each other
+// instead of failing.
+type Migrator struct {
+	dir         string
+	m           *migrate.Migrate
+	db          *sql.DB
+	lockTimeout time.Duration
+}
+
+func (m *Migrator) Close() error {
+	srcErr, dbErr := m.m.Close()
+	return errors.Join(srcErr, dbErr, m.db.Close())
+}
+
+// Up applies all pending migrations.
+func (m *Migrator) Up(ctx context.Context) error {
+	return m.withLock(ctx, true, m.m.Up)
+}
+
+// Down reverts the given number of migrations, or all of them when steps is 0.
+func (m *Migrator) Down(ctx context.Context, steps int) error {
+	return m.withLock(ctx, true, func() error {
+		if steps <= 0 {
+			return m.m.Down()
+		}
+		return m.m.Steps(-steps)
+	})
+}
+
+// Goto migrates up or down to the given version.
+func (m *Migrator) Goto(ctx context.Context, version uint) error {
+	return m.withLock(ctx, true, func() error {
+		return m.m.Migrate(version)
+	})
+}
+
+// Force sets the version without running any migration and clears the dirty
+// state. A version of -1 means no migration is applied.
+func (m *Migrator) Force(ctx context.Context, version int) error {
+	return m.withLock(ctx, false, func() error {
+		return m.m.Force(version)
+	})
+}
+
+type MigrationStatus struct {
+	// Version is the current version. It is 0 when no migration is applied.
+	Version uint `json:"version"`
+	Dirty   bool `json:"dirty"`
+	// Latest is the version of the last migration in the directory.
+	Latest     uint        `json:"latest"`
+	Migrations []Migration `json:"migrations"`
+}
+
+// Pending is the number of migrations which are not applied yet.
+func (s MigrationStatus) Pending() int {
+	n := 0
+	for _, mg := range s.Migrations {
+		if !mg.Applied {
+			n++
+		}
+	}
+	return n
+}
+
+type Migration struct {
+	Version uint   `json:"version"`
+	Name    string `json:"name"`
+	Applied bool   `json:"applied"`
+}
+
+// Status returns the current version and the migrations of the directory.
+func (m *Migrator) Status(ctx context.Context) (*MigrationStatus, error) {
+	version, dirty, err := m.version()
+	if err != nil {
+		return nil, err
+	}
+	migrations, err := readMigrations(m.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &MigrationStatus{
+		Version:    version,
+		Dirty:      dirty,
+		Migrations: migrations,
+	}
+	for i := range status.Migrations {
+		mg := &status.Migrations[i]
+		mg.Applied = mg.Version < version || (mg.Version == version && !dirty)
+		status.Latest = mg.Version
+	}
+	return status, nil
+}
+
+func (m *Migrator) version() (uint, bool, error) {
+	version, dirty, err := m.m.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		return 0, false, nil
+	}
+	return version, dirty, err
+}
+
+// withLock runs fn while holding the migration lock. When checkDirty is set,
+// fn is not run on a dirty database.
+func (m *Migrator) withLock(ctx context.Context, checkDirty bool, fn func() error) error {
+	lockCtx, cancel := context.WithTimeout(ctx, m.lockTimeout)
+	defer cancel()
+	conn, err := m.db.Conn(lockCtx)
+	if err != nil {
 		return err
 	}
+	defer conn.Close()
+
+	start := time.Now()
+	if _, err := conn.ExecContext(lockCtx, "SELECT pg_advisory_lock($1)", migrationLockID); err != nil {
+		if lockCtx.Err() != nil {
+			return fmt.Errorf("timeout after %s waiting for another migration to finish", m.lockTimeout)
+		}
+		return err
+	}
+	defer conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", migrationLockID)
+	if waited := time.Since(start); waited > time.Second {
+		log.Info().Dur("waited", waited).Msg("acquired migration lock")
+	}
 
-	return nil
+	if checkDirty {
+		version, dirty, err := m.version()
+		if err != nil {
+			return err
+		}
+		if dirty {
+			return ErrDirty{Version: version}
+		}
+	}
+
+	err = fn()
+	var dirtyErr migrate.ErrDirty
+	switch {
+	case errors.Is(err, migrate.ErrNoChange):
+		return nil
+	case errors.As(err, &dirtyErr):
+		return ErrDirty{Version: uint(dirtyErr.Version)}
+	}
+	return err
+}
+
+// readMigrations returns the migrations of the directory ordered by their version.
+func readMigrations(dir string) ([]Migration, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var migrations []Migration
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		mg, err := source.Parse(e.Name())
+		if err != nil || mg.Direction != source.Up {
+			continue
+		}
+		migrations = append(migrations, Migration{Version: mg.Version, Name: mg.Identifier})
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+var migrationName = regexp.MustCompile(`[^a-z0-9]+`)
+
+// CreateMigration creates empty up and down files of a new migration following
+// the last migration of the directory, and returns their paths.
+func CreateMigration(dir, name string) ([]string, error) {
+	name = strings.Trim(migrationName.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if name == "" {
+		return nil, errors.New("migration name must not be empty")
+	}
+	migrations, err := readMigrations(dir)
+	if err != nil {
+		return nil, err
+	}
+	var version uint = 1
+	if len(migrations) > 0 {
+		version = migrations[len(migrations)-1].Version + 1
+	}
+
+	var paths []string
+	for _, direction := range []source.Direction{source.Up, source.Down} {
+		path := filepath.Join(dir, fmt.Sprintf("%02d_%s.%s.sql", version, name, direction))
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+		if err != nil {
+			return paths, err
+		}
+		f.Close()
+		paths = append(paths, path)
+	}
+	return paths, nil
 }
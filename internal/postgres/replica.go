@@ -0,0 +1,154 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync/atomic"
+	"time"
+
+	"github.com/imrenagicom/demo-app/internal/config"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+type primaryKey struct{}
+
+// WithPrimary pins the reads done with ctx to the primary, such as the reads
+// of a request which writes, so that it reads its own writes.
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+// UsesPrimary reports whether the reads done with ctx are pinned to the primary.
+func UsesPrimary(ctx context.Context) bool {
+	pinned, _ := ctx.Value(primaryKey{}).(bool)
+	return pinned
+}
+
+// NewRouter opens the replicas of c. They are only used once a health check
+// passed, see Run.
+func NewRouter(c config.SQL) (*Router, error) {
+	r := &Router{
+		maxLag: time.Duration(c.ReplicaMaxLagSec) * time.Second,
+	}
+	for _, dsn := range c.Replicas {
+		db, err := sqlx.Open("postgres", dsn)
+		if err != nil {
+			r.Close()
+			return nil, err
+		}
+		db.SetMaxOpenConns(c.MaxOpenConn)
+		db.SetMaxIdleConns(c.MaxIdleConn)
+		r.replicas = append(r.replicas, &replica{
+			name:  replicaName(dsn),
+			db:    db,
+			cache: sq.NewStmtCache(db),
+		})
+	}
+	return r, nil
+}
+
+// Router spreads reads over the healthy replicas. Reads fall back to the
+// primary when no replica is healthy or the context is pinned to the primary.
+// Reads inside a transaction never use the router.
+type Router struct {
+	replicas []*replica
+	maxLag   time.Duration
+	next     atomic.Uint64
+}
+
+type replica struct {
+	name    string
+	db      *sqlx.DB
+	cache   *sq.StmtCache
+	healthy atomic.Bool
+	// checked is only accessed by the health checks.
+	checked bool
+}
+
+// Replica returns the runner of a healthy replica, or false when the read has
+// to go to the primary.
+func (r *Router) Replica(ctx context.Context) (sq.BaseRunner, bool) {
+	if r == nil || len(r.replicas) == 0 || UsesPrimary(ctx) {
+		return nil, false
+	}
+	start := r.next.Add(1)
+	for i := range r.replicas {
+		rep := r.replicas[(start+uint64(i))%uint64(len(r.replicas))]
+		if rep.healthy.Load() {
+			return rep.cache, true
+		}
+	}
+	return nil, false
+}
+
+// Run checks the health of the replicas every interval until ctx is done.
+func (r *Router) Run(ctx context.Context, interval time.Duration) {
+	r.check(ctx, interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.check(ctx, interval)
+		}
+	}
+}
+
+func (r *Router) check(ctx context.Context, timeout time.Duration) {
+	for _, rep := range r.replicas {
+		err := r.checkReplica(ctx, rep, timeout)
+		healthy := err == nil
+		if ctx.Err() != nil {
+			return
+		}
+		// only changes are logged, and the first check.
+		if rep.healthy.Swap(healthy) != healthy || !rep.checked {
+			rep.checked = true
+			if healthy {
+				log.Info().Str("replica", rep.name).Msg("replica is healthy, routing reads to it")
+			} else {
+				log.Warn().Err(err).Str("replica", rep.name).Msg("replica is unhealthy, routing its reads to the other replicas or the primary")
+			}
+		}
+	}
+}
+
+func (r *Router) checkReplica(ctx context.Context, rep *replica, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	// lag is 0 on a primary and on a replica which replayed everything it received.
+	var lag float64
+	err := rep.db.QueryRowContext(ctx, `SELECT CASE WHEN pg_is_in_recovery() AND pg_last_wal_receive_lsn() <> pg_last_wal_replay_lsn()
+		THEN COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0) ELSE 0 END`).Scan(&lag)
+	if err != nil {
+		return err
+	}
+	if r.maxLag > 0 && lag > r.maxLag.Seconds() {
+		return fmt.Errorf("replica lags %.1fs behind", lag)
+	}
+	return nil
+}
+
+// Close closes the replicas.
+func (r *Router) Close() error {
+	for _, rep := range r.replicas {
+		rep.cache.Clear()
+		rep.db.Close()
+	}
+	return nil
+}
+
+// replicaName returns the host and database of dsn, without credentials.
+func replicaName(dsn string) string {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return "replica"
+	}
+	return u.Host + u.Path
+}
@@ -1,17 +1,41 @@
 package postgres
 
 import (
+	"context"
+	"fmt"
+	"time"
+
 	"github.com/imrenagicom/demo-app/internal/config"
+	"github.com/imrenagicom/demo-app/internal/db"
+	"github.com/imrenagicom/demo-app/internal/retry"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
 )
 
-func NewSQLx(c config.SQL) *sqlx.DB {
-	db, err := sqlx.Open("postgres", c.DataSourceName())
+// connectTimeout bounds a single connection attempt.
+const connectTimeout = 5 * time.Second
+
+// Connect opens the database and pings it, retrying transient failures with
+// the connect retry policy of c, so that the database may start after the server.
+func Connect(ctx context.Context, c config.SQL) (*sqlx.DB, error) {
+	conn, err := sqlx.Open("postgres", c.DataSourceName())
+	if err != nil {
+		return nil, err
+	}
+	conn.SetMaxOpenConns(c.MaxOpenConn)
+	conn.SetMaxIdleConns(c.MaxIdleConn)
+
+	err = retry.Do(ctx, c.ConnectRetry.Policy(), db.IsTransient, func(ctx context.Context) error {
+		ctx, cancel := context.WithTimeout(ctx, connectTimeout)
+		defer cancel()
+		return conn.PingContext(ctx)
+	}, func(attempt int, err error, backoff time.Duration) {
+		log.Warn().Err(err).Int("attempt", attempt).Dur("backoff", backoff).Msg("unable to connect to postgres, retrying")
+	})
 	if err != nil {
-		panic(err)
+		conn.Close()
+		return nil, fmt.Errorf("connect to postgres %s:%s: %w", c.Host, c.Port, err)
 	}
-	db.SetMaxOpenConns(c.MaxOpenConn)
-	db.SetMaxIdleConns(c.MaxIdleConn)
-	return db
+	return conn, nil
 }
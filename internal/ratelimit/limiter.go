@@ -0,0 +1,65 @@
+// Package ratelimit has a token bucket whose rate can change while it is used.
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// NewLimiter returns a limiter allowing rate events per second with bursts of
+// up to burst events. A rate of 0 or less disables the limit.
+func NewLimiter(rate float64, burst int) *Limiter {
+	l := &Limiter{now: time.Now}
+	l.SetLimit(rate, burst)
+	return l
+}
+
+// Limiter is a token bucket safe for concurrent use.
+type Limiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+// SetLimit changes the rate and the burst. Tokens already in the bucket are
+// kept up to the new burst.
+func (l *Limiter) SetLimit(rate float64, burst int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.advance()
+	l.rate = rate
+	l.burst = float64(burst)
+	if l.last.IsZero() || l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+}
+
+// Allow takes a token and reports whether there was one.
+func (l *Limiter) Allow() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.rate <= 0 {
+		return true
+	}
+	l.advance()
+	if l.tokens < 1 {
+		return false
+	}
+	l.tokens--
+	return true
+}
+
+// advance adds the tokens accumulated since the last call.
+func (l *Limiter) advance() {
+	now := l.now()
+	if !l.last.IsZero() && l.rate > 0 {
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+	}
+	l.last = now
+}
@@ -1,14 +1,31 @@
 package redis
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
 	"time"
 
+	"github.com/imrenagicom/demo-app/internal/breaker"
 	"github.com/imrenagicom/demo-app/internal/config"
+	"github.com/imrenagicom/demo-app/internal/retry"
+
 	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
 )
 
+// connectTimeout bounds a single connection attempt.
+const connectTimeout = 5 * time.Second
+
 func New(c config.Redis) *redis.Client {
-	rdb := redis.NewClient(&redis.Options{
+	rdb := newClient(c)
+	addBreaker(rdb, c.Breaker)
+	return rdb
+}
+
+func newClient(c config.Redis) *redis.Client {
+	return redis.NewClient(&redis.Options{
 		Addr:         c.Addr(),
 		Password:     c.Password,
 		DB:           c.DB,
@@ -20,5 +37,84 @@ func New(c config.Redis) *redis.Client {
 		MinIdleConns: c.MinIdleConn,
 		MaxIdleConns: c.MaxIdleConn,
 	})
-	return rdb
+}
+
+func addBreaker(rdb *redis.Client, c config.Breaker) {
+	b := breaker.New(c.FailureThreshold, time.Duration(c.OpenSec)*time.Second, func(from, to breaker.State) {
+		log.Warn().Str("from", from.String()).Str("to", to.String()).Msg("redis circuit breaker changed state")
+	})
+	rdb.AddHook(breakerHook{breaker: b})
+}
+
+// Connect returns the client of c after pinging redis, retrying with the
+// connect retry policy of c. The client is returned together with the error
+// when redis stays unavailable, so that the caller may start without it.
+func Connect(ctx context.Context, c config.Redis) (*redis.Client, error) {
+	// the breaker is added after connecting, so that it does not cut the retries short.
+	rdb := newClient(c)
+	defer addBreaker(rdb, c.Breaker)
+	err := retry.Do(ctx, c.ConnectRetry.Policy(), isUnavailable, func(ctx context.Context) error {
+		ctx, cancel := context.WithTimeout(ctx, connectTimeout)
+		defer cancel()
+		return rdb.Ping(ctx).Err()
+	}, func(attempt int, err error, backoff time.Duration) {
+		log.Warn().Err(err).Int("attempt", attempt).Dur("backoff", backoff).Msg("unable to connect to redis, retrying")
+	})
+	if err != nil {
+		return rdb, fmt.Errorf("connect to redis %s: %w", c.Addr(), err)
+	}
+	return rdb, nil
+}
+
+// isUnavailable reports whether err means redis could not be reached, as
+// opposed to an error reply of redis.
+func isUnavailable(err error) bool {
+	if err == nil || errors.Is(err, redis.Nil) {
+		return false
+	}
+	if errors.Is(err, breaker.ErrOpen) {
+		return true
+	}
+	var reply redis.Error
+	if errors.As(err, &reply) {
+		return false
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, redis.ErrClosed)
+}
+
+// breakerHook fails commands fast with breaker.ErrOpen while redis is
+// unavailable. Error replies, such as a missing key, do not count as failures.
+type breakerHook struct {
+	breaker *breaker.Breaker
+}
+
+func (h breakerHook) DialHook(next redis.DialHook) redis.DialHook {
+	return next
+}
+
+func (h breakerHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		if err := h.breaker.Allow(); err != nil {
+			cmd.SetErr(err)
+			return err
+		}
+		err := next(ctx, cmd)
+		h.breaker.Done(isUnavailable(err))
+		return err
+	}
+}
+
+func (h breakerHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		if err := h.breaker.Allow(); err != nil {
+			for _, cmd := range cmds {
+				cmd.SetErr(err)
+			}
+			return err
+		}
+		err := next(ctx, cmds)
+		h.breaker.Done(isUnavailable(err))
+		return err
+	}
 }
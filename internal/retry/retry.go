@@ -0,0 +1,58 @@
+package retry
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+// Policy is how often and how fast a failed operation is retried. Backoffs
+// grow exponentially from InitialBackoff up to MaxBackoff, with jitter so that
+// clients failing together do not retry together.
+type Policy struct {
+	// MaxAttempts is the number of attempts including the first one. There is
+	// no limit when it is 0, and no retry when it is 1.
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// NoRetry runs operations once.
+var NoRetry = Policy{MaxAttempts: 1}
+
+// Backoff returns how long to wait after the given failed attempt, starting at 1.
+func (p Policy) Backoff(attempt int) time.Duration {
+	d := p.InitialBackoff
+	for i := 1; i < attempt && d < p.MaxBackoff; i++ {
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+	// wait between half and the whole backoff.
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// Do runs fn until it succeeds, returns an error for which retryable returns
+// false, the attempts of p are exhausted or ctx is done. onRetry, when not nil,
+// is called before waiting for the next attempt. The last error of fn is returned.
+func Do(ctx context.Context, p Policy, retryable func(error) bool, fn func(ctx context.Context) error, onRetry ...func(attempt int, err error, backoff time.Duration)) error {
+	for attempt := 1; ; attempt++ {
+		err := fn(ctx)
+		if err == nil || !retryable(err) || (p.MaxAttempts > 0 && attempt >= p.MaxAttempts) {
+			return err
+		}
+		backoff := p.Backoff(attempt)
+		for _, f := range onRetry {
+			f(attempt, err, backoff)
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+	}
+}
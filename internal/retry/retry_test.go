@@ -0,0 +1,85 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+var errTransient = errors.New("transient")
+
+func retryTransient(err error) bool {
+	return errors.Is(err, errTransient)
+}
+
+func TestBackoffGrowsUpToMax(t *testing.T) {
+	p := Policy{InitialBackoff: 10 * time.Millisecond, MaxBackoff: 40 * time.Millisecond}
+	for _, tt := range []struct {
+		attempt int
+		max     time.Duration
+	}{
+		{1, 10 * time.Millisecond},
+		{2, 20 * time.Millisecond},
+		{3, 40 * time.Millisecond},
+		{10, 40 * time.Millisecond},
+	} {
+		for i := 0; i < 100; i++ {
+			if d := p.Backoff(tt.attempt); d < tt.max/2 || d > tt.max {
+				t.Fatalf("backoff of attempt %d = %s, want between %s and %s", tt.attempt, d, tt.max/2, tt.max)
+			}
+		}
+	}
+	if d := (Policy{}).Backoff(1); d != 0 {
+		t.Fatalf("backoff without initial backoff = %s, want 0", d)
+	}
+}
+
+func TestDoRetriesUntilSuccess(t *testing.T) {
+	calls := 0
+	var retries []int
+	err := Do(context.Background(), Policy{MaxAttempts: 5}, retryTransient, func(context.Context) error {
+		calls++
+		if calls < 3 {
+			return errTransient
+		}
+		return nil
+	}, func(attempt int, _ error, _ time.Duration) {
+		retries = append(retries, attempt)
+	})
+	if err != nil {
+		t.Fatalf("Do = %v, want nil", err)
+	}
+	if calls != 3 || len(retries) != 2 || retries[0] != 1 || retries[1] != 2 {
+		t.Fatalf("calls = %d, retries = %v, want 3 calls and retries [1 2]", calls, retries)
+	}
+}
+
+func TestDoStops(t *testing.T) {
+	permanent := errors.New("permanent")
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	for _, tt := range []struct {
+		name  string
+		ctx   context.Context
+		p     Policy
+		err   error
+		calls int
+	}{
+		{"exhausted", context.Background(), Policy{MaxAttempts: 3}, errTransient, 3},
+		{"no retry", context.Background(), NoRetry, errTransient, 1},
+		{"not retryable", context.Background(), Policy{MaxAttempts: 3}, permanent, 1},
+		{"context done", canceled, Policy{MaxAttempts: 0, InitialBackoff: time.Hour}, errTransient, 1},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			err := Do(tt.ctx, tt.p, retryTransient, func(context.Context) error {
+				calls++
+				return tt.err
+			})
+			if !errors.Is(err, tt.err) || calls != tt.calls {
+				t.Fatalf("Do = %v after %d calls, want %v after %d calls", err, calls, tt.err, tt.calls)
+			}
+		})
+	}
+}
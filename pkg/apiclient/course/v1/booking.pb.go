@@ -226,172 +226,6 @@ func (x *Booking) GetFailedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-type Address struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	StreetAddress string `protobuf:"bytes,1,opt,name=street_address,json=streetAddress,proto3" json:"street_address,omitempty"`
-	AptSuite      string `protobuf:"bytes,2,opt,name=apt_suite,json=aptSuite,proto3" json:"apt_suite,omitempty"`
-	City          string `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
-	Country       string `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
-	ZipCode       string `protobuf:"bytes,5,opt,name=zip_code,json=zipCode,proto3" json:"zip_code,omitempty"`
-	State         string `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
-}
-
-func (x *Address) Reset() {
-	*x = Address{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Address) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Address) ProtoMessage() {}
-
-func (x *Address) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Address.ProtoReflect.Descriptor instead.
-func (*Address) Descriptor() ([]byte, []int) {
-	return file_pkg_apiclient_course_v1_booking_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *Address) GetStreetAddress() string {
-	if x != nil {
-		return x.StreetAddress
-	}
-	return ""
-}
-
-func (x *Address) GetAptSuite() string {
-	if x != nil {
-		return x.AptSuite
-	}
-	return ""
-}
-
-func (x *Address) GetCity() string {
-	if x != nil {
-		return x.City
-	}
-	return ""
-}
-
-func (x *Address) GetCountry() string {
-	if x != nil {
-		return x.Country
-	}
-	return ""
-}
-
-func (x *Address) GetZipCode() string {
-	if x != nil {
-		return x.ZipCode
-	}
-	return ""
-}
-
-func (x *Address) GetState() string {
-	if x != nil {
-		return x.State
-	}
-	return ""
-}
-
-type Customer struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Name            string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Email           string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	PhoneNumber     string   `protobuf:"bytes,3,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
-	ShippingAddress *Address `protobuf:"bytes,4,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`
-	BillingAddress  *Address `protobuf:"bytes,5,opt,name=billing_address,json=billingAddress,proto3" json:"billing_address,omitempty"`
-}
-
-func (x *Customer) Reset() {
-	*x = Customer{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Customer) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Customer) ProtoMessage() {}
-
-func (x *Customer) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Customer.ProtoReflect.Descriptor instead.
-func (*Customer) Descriptor() ([]byte, []int) {
-	return file_pkg_apiclient_course_v1_booking_proto_rawDescGZIP(), []int{2}
-}
-
-func (x *Customer) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Customer) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
-}
-
-func (x *Customer) GetPhoneNumber() string {
-	if x != nil {
-		return x.PhoneNumber
-	}
-	return ""
-}
-
-func (x *Customer) GetShippingAddress() *Address {
-	if x != nil {
-		return x.ShippingAddress
-	}
-	return nil
-}
-
-func (x *Customer) GetBillingAddress() *Address {
-	if x != nil {
-		return x.BillingAddress
-	}
-	return nil
-}
-
 type Payment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -404,7 +238,7 @@ type Payment struct {
 func (x *Payment) Reset() {
 	*x = Payment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[3]
+		mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -417,7 +251,7 @@ func (x *Payment) String() string {
 func (*Payment) ProtoMessage() {}
 
 func (x *Payment) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[3]
+	mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -430,7 +264,7 @@ func (x *Payment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Payment.ProtoReflect.Descriptor instead.
 func (*Payment) Descriptor() ([]byte, []int) {
-	return file_pkg_apiclient_course_v1_booking_proto_rawDescGZIP(), []int{3}
+	return file_pkg_apiclient_course_v1_booking_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *Payment) GetInvoiceNumber() string {
@@ -458,7 +292,7 @@ type CreateBookingRequest struct {
 func (x *CreateBookingRequest) Reset() {
 	*x = CreateBookingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[4]
+		mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -471,7 +305,7 @@ func (x *CreateBookingRequest) String() string {
 func (*CreateBookingRequest) ProtoMessage() {}
 
 func (x *CreateBookingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[4]
+	mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -484,7 +318,7 @@ func (x *CreateBookingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBookingRequest.ProtoReflect.Descriptor instead.
 func (*CreateBookingRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_apiclient_course_v1_booking_proto_rawDescGZIP(), []int{4}
+	return file_pkg_apiclient_course_v1_booking_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateBookingRequest) GetBooking() *Booking {
@@ -505,7 +339,7 @@ type GetBookingRequest struct {
 func (x *GetBookingRequest) Reset() {
 	*x = GetBookingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[5]
+		mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -518,7 +352,7 @@ func (x *GetBookingRequest) String() string {
 func (*GetBookingRequest) ProtoMessage() {}
 
 func (x *GetBookingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[5]
+	mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -531,7 +365,7 @@ func (x *GetBookingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBookingRequest.ProtoReflect.Descriptor instead.
 func (*GetBookingRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_apiclient_course_v1_booking_proto_rawDescGZIP(), []int{5}
+	return file_pkg_apiclient_course_v1_booking_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetBookingRequest) GetBooking() string {
@@ -552,7 +386,7 @@ type ReserveBookingRequest struct {
 func (x *ReserveBookingRequest) Reset() {
 	*x = ReserveBookingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[6]
+		mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -565,7 +399,7 @@ func (x *ReserveBookingRequest) String() string {
 func (*ReserveBookingRequest) ProtoMessage() {}
 
 func (x *ReserveBookingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[6]
+	mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -578,7 +412,7 @@ func (x *ReserveBookingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReserveBookingRequest.ProtoReflect.Descriptor instead.
 func (*ReserveBookingRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_apiclient_course_v1_booking_proto_rawDescGZIP(), []int{6}
+	return file_pkg_apiclient_course_v1_booking_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ReserveBookingRequest) GetBooking() string {
@@ -597,7 +431,7 @@ type ReserveBookingResponse struct {
 func (x *ReserveBookingResponse) Reset() {
 	*x = ReserveBookingResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[7]
+		mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -610,7 +444,7 @@ func (x *ReserveBookingResponse) String() string {
 func (*ReserveBookingResponse) ProtoMessage() {}
 
 func (x *ReserveBookingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[7]
+	mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -623,7 +457,7 @@ func (x *ReserveBookingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReserveBookingResponse.ProtoReflect.Descriptor instead.
 func (*ReserveBookingResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_apiclient_course_v1_booking_proto_rawDescGZIP(), []int{7}
+	return file_pkg_apiclient_course_v1_booking_proto_rawDescGZIP(), []int{5}
 }
 
 type SetPaymentDetailRequest struct {
@@ -639,7 +473,7 @@ type SetPaymentDetailRequest struct {
 func (x *SetPaymentDetailRequest) Reset() {
 	*x = SetPaymentDetailRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[8]
+		mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -652,7 +486,7 @@ func (x *SetPaymentDetailRequest) String() string {
 func (*SetPaymentDetailRequest) ProtoMessage() {}
 
 func (x *SetPaymentDetailRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[8]
+	mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -665,7 +499,7 @@ func (x *SetPaymentDetailRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetPaymentDetailRequest.ProtoReflect.Descriptor instead.
 func (*SetPaymentDetailRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_apiclient_course_v1_booking_proto_rawDescGZIP(), []int{8}
+	return file_pkg_apiclient_course_v1_booking_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SetPaymentDetailRequest) GetBooking() string {
@@ -698,7 +532,7 @@ type SetPaymentDetailResponse struct {
 func (x *SetPaymentDetailResponse) Reset() {
 	*x = SetPaymentDetailResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[9]
+		mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -711,7 +545,7 @@ func (x *SetPaymentDetailResponse) String() string {
 func (*SetPaymentDetailResponse) ProtoMessage() {}
 
 func (x *SetPaymentDetailResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[9]
+	mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -724,7 +558,7 @@ func (x *SetPaymentDetailResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetPaymentDetailResponse.ProtoReflect.Descriptor instead.
 func (*SetPaymentDetailResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_apiclient_course_v1_booking_proto_rawDescGZIP(), []int{9}
+	return file_pkg_apiclient_course_v1_booking_proto_rawDescGZIP(), []int{7}
 }
 
 type ExpireBookingRequest struct {
@@ -738,7 +572,7 @@ type ExpireBookingRequest struct {
 func (x *ExpireBookingRequest) Reset() {
 	*x = ExpireBookingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[10]
+		mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -751,7 +585,7 @@ func (x *ExpireBookingRequest) String() string {
 func (*ExpireBookingRequest) ProtoMessage() {}
 
 func (x *ExpireBookingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[10]
+	mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -764,7 +598,7 @@ func (x *ExpireBookingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExpireBookingRequest.ProtoReflect.Descriptor instead.
 func (*ExpireBookingRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_apiclient_course_v1_booking_proto_rawDescGZIP(), []int{10}
+	return file_pkg_apiclient_course_v1_booking_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ExpireBookingRequest) GetBooking() string {
@@ -783,7 +617,7 @@ type ExpireBookingResponse struct {
 func (x *ExpireBookingResponse) Reset() {
 	*x = ExpireBookingResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[11]
+		mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -796,7 +630,7 @@ func (x *ExpireBookingResponse) String() string {
 func (*ExpireBookingResponse) ProtoMessage() {}
 
 func (x *ExpireBookingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[11]
+	mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -809,7 +643,7 @@ func (x *ExpireBookingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExpireBookingResponse.ProtoReflect.Descriptor instead.
 func (*ExpireBookingResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_apiclient_course_v1_booking_proto_rawDescGZIP(), []int{11}
+	return file_pkg_apiclient_course_v1_booking_proto_rawDescGZIP(), []int{9}
 }
 
 type ListBookingsRequest struct {
@@ -820,16 +654,21 @@ type ListBookingsRequest struct {
 	// invoice number of the booking used for filtering.
 	Invoice string `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
 	// booking status used for filtering.
-	Status    Status `protobuf:"varint,2,opt,name=status,proto3,enum=imrenagicom.demoapp.course.v1.Status" json:"status,omitempty"`
-	PageSize  uint64 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Status   Status `protobuf:"varint,2,opt,name=status,proto3,enum=imrenagicom.demoapp.course.v1.Status" json:"status,omitempty"`
+	PageSize uint64 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// token returned by the previous call. It is only valid together with the
+	// same order_by and filters.
 	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	OrderBy   string `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// "created_at", optionally followed by "asc" or "desc". Defaults to "created_at desc".
+	OrderBy string `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// customer id used for filtering.
+	Customer string `protobuf:"bytes,6,opt,name=customer,proto3" json:"customer,omitempty"`
 }
 
 func (x *ListBookingsRequest) Reset() {
 	*x = ListBookingsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[12]
+		mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -842,7 +681,7 @@ func (x *ListBookingsRequest) String() string {
 func (*ListBookingsRequest) ProtoMessage() {}
 
 func (x *ListBookingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[12]
+	mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -855,7 +694,7 @@ func (x *ListBookingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBookingsRequest.ProtoReflect.Descriptor instead.
 func (*ListBookingsRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_apiclient_course_v1_booking_proto_rawDescGZIP(), []int{12}
+	return file_pkg_apiclient_course_v1_booking_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListBookingsRequest) GetInvoice() string {
@@ -893,6 +732,13 @@ func (x *ListBookingsRequest) GetOrderBy() string {
 	return ""
 }
 
+func (x *ListBookingsRequest) GetCustomer() string {
+	if x != nil {
+		return x.Customer
+	}
+	return ""
+}
+
 type ListBookingsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -905,7 +751,7 @@ type ListBookingsResponse struct {
 func (x *ListBookingsResponse) Reset() {
 	*x = ListBookingsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[13]
+		mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -918,7 +764,7 @@ func (x *ListBookingsResponse) String() string {
 func (*ListBookingsResponse) ProtoMessage() {}
 
 func (x *ListBookingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[13]
+	mi := &file_pkg_apiclient_course_v1_booking_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -931,7 +777,7 @@ func (x *ListBookingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBookingsResponse.ProtoReflect.Descriptor instead.
 func (*ListBookingsResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_apiclient_course_v1_booking_proto_rawDescGZIP(), []int{13}
+	return file_pkg_apiclient_course_v1_booking_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListBookingsResponse) GetBookings() []*Booking {
@@ -973,227 +819,210 @@ var file_pkg_apiclient_course_v1_booking_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73,
 	0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x25, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69,
 	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2f, 0x76, 0x31,
-	0x2f, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xba,
-	0x06, 0x0a, 0x07, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x06, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52,
-	0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0xfa, 0x41, 0x23, 0x0a, 0x21, 0x63, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6d, 0x72,
-	0x65, 0x6e, 0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52,
-	0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xfa, 0x41, 0x28, 0x0a, 0x26, 0x63, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6d, 0x72, 0x65,
-	0x6e, 0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x12, 0x19, 0x0a, 0x05, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x05,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
-	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x08, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x42, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67,
-	0x69, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x61, 0x70, 0x70, 0x2e, 0x63, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x03, 0xe0,
-	0x41, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3e, 0x0a, 0x0a, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x0b, 0x72, 0x65,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x03,
-	0x52, 0x0a, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x07,
-	0x70, 0x61, 0x69, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x06,
-	0x70, 0x61, 0x69, 0x64, 0x41, 0x74, 0x12, 0x43, 0x0a, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e,
-	0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x61, 0x70, 0x70, 0x2e, 0x63,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
-	0x72, 0x52, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x07, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69,
-	0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x61,
-	0x70, 0x70, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3e, 0x0a,
-	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x03, 0xe0,
-	0x41, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3c, 0x0a,
-	0x09, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x03, 0xe0, 0x41,
-	0x03, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x3a, 0x4e, 0xea, 0x41, 0x4b,
-	0x0a, 0x22, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x61, 0x70, 0x70,
-	0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d, 0x2f, 0x42, 0x6f, 0x6f,
-	0x6b, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b,
-	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x7d, 0x2a, 0x08, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
-	0x67, 0x73, 0x32, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0xac, 0x01, 0x0a, 0x07,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x72, 0x65, 0x65,
-	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b,
-	0x0a, 0x09, 0x61, 0x70, 0x74, 0x5f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x61, 0x70, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63,
-	0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12,
-	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x7a, 0x69, 0x70,
-	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x7a, 0x69, 0x70,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0xfb, 0x01, 0x0a, 0x08, 0x43,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
-	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
-	0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x12, 0x51, 0x0a, 0x10, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
-	0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x65, 0x6d,
-	0x6f, 0x61, 0x70, 0x70, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0f, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x4f, 0x0a, 0x0f, 0x62, 0x69, 0x6c, 0x6c, 0x69,
-	0x6e, 0x67, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d, 0x2e, 0x64,
-	0x65, 0x6d, 0x6f, 0x61, 0x70, 0x70, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
-	0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x4d, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x6e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x03,
-	0x52, 0x0d, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
-	0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0x5d, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x45, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d, 0x2e, 0x64,
-	0x65, 0x6d, 0x6f, 0x61, 0x70, 0x70, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x07, 0x62,
-	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x59, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f,
-	0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x07, 0x62,
-	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2a, 0xe0, 0x41,
-	0x02, 0xfa, 0x41, 0x24, 0x0a, 0x22, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x64, 0x65, 0x6d,
+	0x2f, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x26,
+	0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xba, 0x06, 0x0a, 0x07, 0x42, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x3e, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x26, 0xfa, 0x41, 0x23, 0x0a, 0x21, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x64, 0x65, 0x6d,
 	0x6f, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d,
-	0x2f, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
-	0x67, 0x22, 0x5d, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x42, 0x6f, 0x6f, 0x6b,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x07, 0x62, 0x6f,
-	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2a, 0xe0, 0x41, 0x02,
-	0xfa, 0x41, 0x24, 0x0a, 0x22, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x64, 0x65, 0x6d, 0x6f,
+	0x2f, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12,
+	0x41, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b,
+	0xfa, 0x41, 0x28, 0x0a, 0x26, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x64, 0x65, 0x6d, 0x6f,
 	0x61, 0x70, 0x70, 0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d, 0x2f,
-	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
-	0x22, 0x18, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf0, 0x01, 0x0a, 0x17, 0x53,
-	0x65, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x19, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a,
+	0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x03, 0xe0, 0x41, 0x03, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x42,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25,
+	0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x65, 0x6d,
+	0x6f, 0x61, 0x70, 0x70, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x3e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x40, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x07, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x06, 0x70, 0x61, 0x69, 0x64, 0x41, 0x74, 0x12, 0x43,
+	0x0a, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d, 0x2e, 0x64,
+	0x65, 0x6d, 0x6f, 0x61, 0x70, 0x70, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67, 0x69, 0x63,
+	0x6f, 0x6d, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x61, 0x70, 0x70, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3c, 0x0a, 0x09, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x41, 0x74, 0x3a, 0x4e, 0xea, 0x41, 0x4b, 0x0a, 0x22, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67,
+	0x69, 0x63, 0x6f, 0x6d, 0x2f, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x7d,
+	0x2a, 0x08, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x32, 0x07, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x22, 0x4d, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2a,
+	0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0d, 0x69, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x22, 0x5d, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x07, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x6d,
+	0x72, 0x65, 0x6e, 0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x61, 0x70,
+	0x70, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x22, 0x59, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
 	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2a, 0xe0, 0x41, 0x02, 0xfa, 0x41, 0x24, 0x0a,
 	0x22, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x61, 0x70, 0x70, 0x2e,
 	0x69, 0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d, 0x2f, 0x42, 0x6f, 0x6f, 0x6b,
-	0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x45, 0x0a, 0x07,
-	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
-	0x69, 0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x65, 0x6d, 0x6f,
-	0x61, 0x70, 0x70, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x48, 0x0a, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67, 0x69,
-	0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x61, 0x70, 0x70, 0x2e, 0x63, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x42, 0x03,
-	0xe0, 0x41, 0x02, 0x52, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x22, 0x1a, 0x0a,
-	0x18, 0x53, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5c, 0x0a, 0x14, 0x45, 0x78, 0x70,
-	0x69, 0x72, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x5d, 0x0a, 0x15,
+	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2a, 0xe0, 0x41, 0x02, 0xfa, 0x41, 0x24, 0x0a, 0x22,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x61, 0x70, 0x70, 0x2e, 0x69,
+	0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d, 0x2f, 0x42, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x18, 0x0a, 0x16, 0x52,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf0, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x44, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x42, 0x2a, 0xe0, 0x41, 0x02, 0xfa, 0x41, 0x24, 0x0a, 0x22, 0x63, 0x6f, 0x75, 0x72,
 	0x73, 0x65, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e,
 	0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d, 0x2f, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x07,
-	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x17, 0x0a, 0x15, 0x45, 0x78, 0x70, 0x69, 0x72,
-	0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0xf2, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f,
-	0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xe0, 0x41, 0x01, 0xfa, 0x41,
-	0x25, 0x0a, 0x23, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x61,
-	0x70, 0x70, 0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d, 0x2f, 0x49,
-	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12,
-	0x3d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x25, 0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x65,
-	0x6d, 0x6f, 0x61, 0x70, 0x70, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b,
-	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
-	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x42, 0x79, 0x22, 0x82, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f,
-	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42,
-	0x0a, 0x08, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d, 0x2e, 0x64,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x45, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e,
+	0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x61, 0x70, 0x70, 0x2e, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x48,
+	0x0a, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d, 0x2e, 0x64,
 	0x65, 0x6d, 0x6f, 0x61, 0x70, 0x70, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
-	0x67, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78,
-	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x2a, 0x64, 0x0a, 0x06, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x13, 0x42, 0x4f, 0x4f, 0x4b, 0x49, 0x4e, 0x47, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a,
-	0x07, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45,
-	0x53, 0x45, 0x52, 0x56, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4d, 0x50,
-	0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45,
-	0x44, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x05,
-	0x32, 0x9f, 0x07, 0x0a, 0x0e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0xa9, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b,
-	0x69, 0x6e, 0x67, 0x73, 0x12, 0x32, 0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67, 0x69, 0x63,
+	0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x08,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5c, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x42, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x07,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2a, 0xe0,
+	0x41, 0x02, 0xfa, 0x41, 0x24, 0x0a, 0x22, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x64, 0x65,
+	0x6d, 0x6f, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67, 0x69, 0x63, 0x6f,
+	0x6d, 0x2f, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x22, 0x17, 0x0a, 0x15, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x42, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbb, 0x02, 0x0a, 0x13,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xe0, 0x41, 0x01, 0xfa, 0x41, 0x25, 0x0a, 0x23, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6d,
+	0x72, 0x65, 0x6e, 0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d, 0x2f, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x69, 0x6d, 0x72,
+	0x65, 0x6e, 0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x61, 0x70, 0x70,
+	0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79,
+	0x12, 0x47, 0x0a, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x2b, 0xe0, 0x41, 0x01, 0xfa, 0x41, 0x25, 0x0a, 0x23, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e,
+	0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52,
+	0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x22, 0x82, 0x01, 0x0a, 0x14, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x42, 0x0a, 0x08, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67, 0x69, 0x63,
 	0x6f, 0x6d, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x61, 0x70, 0x70, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x2a, 0x64,
+	0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x13, 0x42, 0x4f, 0x4f, 0x4b,
+	0x49, 0x4e, 0x47, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c,
+	0x0a, 0x08, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09,
+	0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x46,
+	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x58, 0x50, 0x49, 0x52,
+	0x45, 0x44, 0x10, 0x05, 0x32, 0xcf, 0x07, 0x0a, 0x0e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xd9, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x32, 0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e,
 	0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x61, 0x70, 0x70, 0x2e, 0x63,
 	0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f,
-	0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x92,
-	0x41, 0x0e, 0x12, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12,
-	0xad, 0x01, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
-	0x67, 0x12, 0x33, 0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d, 0x2e,
-	0x64, 0x65, 0x6d, 0x6f, 0x61, 0x70, 0x70, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67,
-	0x69, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x61, 0x70, 0x70, 0x2e, 0x63, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x3f,
-	0x92, 0x41, 0x14, 0x12, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x6e, 0x65, 0x77, 0x20,
-	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x07, 0x62,
-	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12,
-	0xa1, 0x01, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x30,
-	0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x65, 0x6d,
-	0x6f, 0x61, 0x70, 0x70, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x26, 0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d, 0x2e, 0x64,
-	0x65, 0x6d, 0x6f, 0x61, 0x70, 0x70, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x39, 0x92, 0x41, 0x0d, 0x12, 0x0b, 0x47,
-	0x65, 0x74, 0x20, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23,
-	0x12, 0x21, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2f, 0x76, 0x31,
-	0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
-	0x6e, 0x67, 0x7d, 0x12, 0xc7, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x42,
-	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x34, 0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67,
-	0x69, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x61, 0x70, 0x70, 0x2e, 0x63, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x42, 0x6f,
-	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x69,
+	0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x69,
 	0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x61,
-	0x70, 0x70, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x48, 0x92, 0x41, 0x11, 0x12, 0x0f, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x20, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x3a,
-	0x01, 0x2a, 0x22, 0x29, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2f,
-	0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x62, 0x6f, 0x6f,
-	0x6b, 0x69, 0x6e, 0x67, 0x7d, 0x3a, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x12, 0xc2, 0x01,
-	0x0a, 0x0d, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12,
-	0x33, 0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x65,
-	0x6d, 0x6f, 0x61, 0x70, 0x70, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67, 0x69, 0x63,
+	0x70, 0x70, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x60, 0x92, 0x41, 0x0e, 0x12, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x62, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x49, 0x5a, 0x2e, 0x12, 0x2c, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x7d, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0xad, 0x01, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x33, 0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67, 0x69,
+	0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x61, 0x70, 0x70, 0x2e, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x69, 0x6d, 0x72,
+	0x65, 0x6e, 0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x61, 0x70, 0x70,
+	0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x22, 0x3f, 0x92, 0x41, 0x14, 0x12, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20,
+	0x6e, 0x65, 0x77, 0x20, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x22, 0x3a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x17, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0xa1, 0x01, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x12, 0x30, 0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d,
+	0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x61, 0x70, 0x70, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67, 0x69, 0x63,
 	0x6f, 0x6d, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x61, 0x70, 0x70, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x92, 0x41, 0x10, 0x12,
-	0x0e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x20, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x3a, 0x01, 0x2a, 0x22, 0x28, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
-	0x73, 0x2f, 0x7b, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x7d, 0x3a, 0x65, 0x78, 0x70, 0x69,
-	0x72, 0x65, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x69, 0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x65, 0x6d,
-	0x6f, 0x2d, 0x61, 0x70, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x39, 0x92, 0x41,
+	0x0d, 0x12, 0x0b, 0x47, 0x65, 0x74, 0x20, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x7d, 0x12, 0xc7, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x34, 0x2e, 0x69, 0x6d, 0x72,
+	0x65, 0x6e, 0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x61, 0x70, 0x70,
+	0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x35, 0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d, 0x2e, 0x64,
+	0x65, 0x6d, 0x6f, 0x61, 0x70, 0x70, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x92, 0x41, 0x11, 0x12, 0x0f, 0x52, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x20, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x2e, 0x3a, 0x01, 0x2a, 0x22, 0x29, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x2f,
+	0x7b, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x7d, 0x3a, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x12, 0xc2, 0x01, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x42, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x12, 0x33, 0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67, 0x69, 0x63, 0x6f,
+	0x6d, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x61, 0x70, 0x70, 0x2e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x69, 0x6d, 0x72, 0x65, 0x6e,
+	0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x61, 0x70, 0x70, 0x2e, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x42,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46,
+	0x92, 0x41, 0x10, 0x12, 0x0e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x20, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x3a, 0x01, 0x2a, 0x22, 0x28, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x7d, 0x3a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6d, 0x72, 0x65, 0x6e, 0x61, 0x67, 0x69, 0x63, 0x6f, 0x6d,
+	0x2f, 0x64, 0x65, 0x6d, 0x6f, 0x2d, 0x61, 0x70, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70,
+	0x69, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2f, 0x76,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1209,56 +1038,53 @@ func file_pkg_apiclient_course_v1_booking_proto_rawDescGZIP() []byte {
 }
 
 var file_pkg_apiclient_course_v1_booking_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_pkg_apiclient_course_v1_booking_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_pkg_apiclient_course_v1_booking_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_pkg_apiclient_course_v1_booking_proto_goTypes = []interface{}{
 	(Status)(0),                      // 0: imrenagicom.demoapp.course.v1.Status
 	(*Booking)(nil),                  // 1: imrenagicom.demoapp.course.v1.Booking
-	(*Address)(nil),                  // 2: imrenagicom.demoapp.course.v1.Address
-	(*Customer)(nil),                 // 3: imrenagicom.demoapp.course.v1.Customer
-	(*Payment)(nil),                  // 4: imrenagicom.demoapp.course.v1.Payment
-	(*CreateBookingRequest)(nil),     // 5: imrenagicom.demoapp.course.v1.CreateBookingRequest
-	(*GetBookingRequest)(nil),        // 6: imrenagicom.demoapp.course.v1.GetBookingRequest
-	(*ReserveBookingRequest)(nil),    // 7: imrenagicom.demoapp.course.v1.ReserveBookingRequest
-	(*ReserveBookingResponse)(nil),   // 8: imrenagicom.demoapp.course.v1.ReserveBookingResponse
-	(*SetPaymentDetailRequest)(nil),  // 9: imrenagicom.demoapp.course.v1.SetPaymentDetailRequest
-	(*SetPaymentDetailResponse)(nil), // 10: imrenagicom.demoapp.course.v1.SetPaymentDetailResponse
-	(*ExpireBookingRequest)(nil),     // 11: imrenagicom.demoapp.course.v1.ExpireBookingRequest
-	(*ExpireBookingResponse)(nil),    // 12: imrenagicom.demoapp.course.v1.ExpireBookingResponse
-	(*ListBookingsRequest)(nil),      // 13: imrenagicom.demoapp.course.v1.ListBookingsRequest
-	(*ListBookingsResponse)(nil),     // 14: imrenagicom.demoapp.course.v1.ListBookingsResponse
-	(*timestamppb.Timestamp)(nil),    // 15: google.protobuf.Timestamp
+	(*Payment)(nil),                  // 2: imrenagicom.demoapp.course.v1.Payment
+	(*CreateBookingRequest)(nil),     // 3: imrenagicom.demoapp.course.v1.CreateBookingRequest
+	(*GetBookingRequest)(nil),        // 4: imrenagicom.demoapp.course.v1.GetBookingRequest
+	(*ReserveBookingRequest)(nil),    // 5: imrenagicom.demoapp.course.v1.ReserveBookingRequest
+	(*ReserveBookingResponse)(nil),   // 6: imrenagicom.demoapp.course.v1.ReserveBookingResponse
+	(*SetPaymentDetailRequest)(nil),  // 7: imrenagicom.demoapp.course.v1.SetPaymentDetailRequest
+	(*SetPaymentDetailResponse)(nil), // 8: imrenagicom.demoapp.course.v1.SetPaymentDetailResponse
+	(*ExpireBookingRequest)(nil),     // 9: imrenagicom.demoapp.course.v1.ExpireBookingRequest
+	(*ExpireBookingResponse)(nil),    // 10: imrenagicom.demoapp.course.v1.ExpireBookingResponse
+	(*ListBookingsRequest)(nil),      // 11: imrenagicom.demoapp.course.v1.ListBookingsRequest
+	(*ListBookingsResponse)(nil),     // 12: imrenagicom.demoapp.course.v1.ListBookingsResponse
+	(*timestamppb.Timestamp)(nil),    // 13: google.protobuf.Timestamp
+	(*Customer)(nil),                 // 14: imrenagicom.demoapp.course.v1.Customer
 }
 var file_pkg_apiclient_course_v1_booking_proto_depIdxs = []int32{
 	0,  // 0: imrenagicom.demoapp.course.v1.Booking.status:type_name -> imrenagicom.demoapp.course.v1.Status
-	15, // 1: imrenagicom.demoapp.course.v1.Booking.created_at:type_name -> google.protobuf.Timestamp
-	15, // 2: imrenagicom.demoapp.course.v1.Booking.reserved_at:type_name -> google.protobuf.Timestamp
-	15, // 3: imrenagicom.demoapp.course.v1.Booking.paid_at:type_name -> google.protobuf.Timestamp
-	3,  // 4: imrenagicom.demoapp.course.v1.Booking.customer:type_name -> imrenagicom.demoapp.course.v1.Customer
-	4,  // 5: imrenagicom.demoapp.course.v1.Booking.payment:type_name -> imrenagicom.demoapp.course.v1.Payment
-	15, // 6: imrenagicom.demoapp.course.v1.Booking.expired_at:type_name -> google.protobuf.Timestamp
-	15, // 7: imrenagicom.demoapp.course.v1.Booking.failed_at:type_name -> google.protobuf.Timestamp
-	2,  // 8: imrenagicom.demoapp.course.v1.Customer.shipping_address:type_name -> imrenagicom.demoapp.course.v1.Address
-	2,  // 9: imrenagicom.demoapp.course.v1.Customer.billing_address:type_name -> imrenagicom.demoapp.course.v1.Address
-	1,  // 10: imrenagicom.demoapp.course.v1.CreateBookingRequest.booking:type_name -> imrenagicom.demoapp.course.v1.Booking
-	4,  // 11: imrenagicom.demoapp.course.v1.SetPaymentDetailRequest.payment:type_name -> imrenagicom.demoapp.course.v1.Payment
-	3,  // 12: imrenagicom.demoapp.course.v1.SetPaymentDetailRequest.customer:type_name -> imrenagicom.demoapp.course.v1.Customer
-	0,  // 13: imrenagicom.demoapp.course.v1.ListBookingsRequest.status:type_name -> imrenagicom.demoapp.course.v1.Status
-	1,  // 14: imrenagicom.demoapp.course.v1.ListBookingsResponse.bookings:type_name -> imrenagicom.demoapp.course.v1.Booking
-	13, // 15: imrenagicom.demoapp.course.v1.BookingService.ListBookings:input_type -> imrenagicom.demoapp.course.v1.ListBookingsRequest
-	5,  // 16: imrenagicom.demoapp.course.v1.BookingService.CreateBooking:input_type -> imrenagicom.demoapp.course.v1.CreateBookingRequest
-	6,  // 17: imrenagicom.demoapp.course.v1.BookingService.GetBooking:input_type -> imrenagicom.demoapp.course.v1.GetBookingRequest
-	7,  // 18: imrenagicom.demoapp.course.v1.BookingService.ReserveBooking:input_type -> imrenagicom.demoapp.course.v1.ReserveBookingRequest
-	11, // 19: imrenagicom.demoapp.course.v1.BookingService.ExpireBooking:input_type -> imrenagicom.demoapp.course.v1.ExpireBookingRequest
-	14, // 20: imrenagicom.demoapp.course.v1.BookingService.ListBookings:output_type -> imrenagicom.demoapp.course.v1.ListBookingsResponse
-	1,  // 21: imrenagicom.demoapp.course.v1.BookingService.CreateBooking:output_type -> imrenagicom.demoapp.course.v1.Booking
-	1,  // 22: imrenagicom.demoapp.course.v1.BookingService.GetBooking:output_type -> imrenagicom.demoapp.course.v1.Booking
-	8,  // 23: imrenagicom.demoapp.course.v1.BookingService.ReserveBooking:output_type -> imrenagicom.demoapp.course.v1.ReserveBookingResponse
-	12, // 24: imrenagicom.demoapp.course.v1.BookingService.ExpireBooking:output_type -> imrenagicom.demoapp.course.v1.ExpireBookingResponse
-	20, // [20:25] is the sub-list for method output_type
-	15, // [15:20] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	13, // 1: imrenagicom.demoapp.course.v1.Booking.created_at:type_name -> google.protobuf.Timestamp
+	13, // 2: imrenagicom.demoapp.course.v1.Booking.reserved_at:type_name -> google.protobuf.Timestamp
+	13, // 3: imrenagicom.demoapp.course.v1.Booking.paid_at:type_name -> google.protobuf.Timestamp
+	14, // 4: imrenagicom.demoapp.course.v1.Booking.customer:type_name -> imrenagicom.demoapp.course.v1.Customer
+	2,  // 5: imrenagicom.demoapp.course.v1.Booking.payment:type_name -> imrenagicom.demoapp.course.v1.Payment
+	13, // 6: imrenagicom.demoapp.course.v1.Booking.expired_at:type_name -> google.protobuf.Timestamp
+	13, // 7: imrenagicom.demoapp.course.v1.Booking.failed_at:type_name -> google.protobuf.Timestamp
+	1,  // 8: imrenagicom.demoapp.course.v1.CreateBookingRequest.booking:type_name -> imrenagicom.demoapp.course.v1.Booking
+	2,  // 9: imrenagicom.demoapp.course.v1.SetPaymentDetailRequest.payment:type_name -> imrenagicom.demoapp.course.v1.Payment
+	14, // 10: imrenagicom.demoapp.course.v1.SetPaymentDetailRequest.customer:type_name -> imrenagicom.demoapp.course.v1.Customer
+	0,  // 11: imrenagicom.demoapp.course.v1.ListBookingsRequest.status:type_name -> imrenagicom.demoapp.course.v1.Status
+	1,  // 12: imrenagicom.demoapp.course.v1.ListBookingsResponse.bookings:type_name -> imrenagicom.demoapp.course.v1.Booking
+	11, // 13: imrenagicom.demoapp.course.v1.BookingService.ListBookings:input_type -> imrenagicom.demoapp.course.v1.ListBookingsRequest
+	3,  // 14: imrenagicom.demoapp.course.v1.BookingService.CreateBooking:input_type -> imrenagicom.demoapp.course.v1.CreateBookingRequest
+	4,  // 15: imrenagicom.demoapp.course.v1.BookingService.GetBooking:input_type -> imrenagicom.demoapp.course.v1.GetBookingRequest
+	5,  // 16: imrenagicom.demoapp.course.v1.BookingService.ReserveBooking:input_type -> imrenagicom.demoapp.course.v1.ReserveBookingRequest
+	9,  // 17: imrenagicom.demoapp.course.v1.BookingService.ExpireBooking:input_type -> imrenagicom.demoapp.course.v1.ExpireBookingRequest
+	12, // 18: imrenagicom.demoapp.course.v1.BookingService.ListBookings:output_type -> imrenagicom.demoapp.course.v1.ListBookingsResponse
+	1,  // 19: imrenagicom.demoapp.course.v1.BookingService.CreateBooking:output_type -> imrenagicom.demoapp.course.v1.Booking
+	1,  // 20: imrenagicom.demoapp.course.v1.BookingService.GetBooking:output_type -> imrenagicom.demoapp.course.v1.Booking
+	6,  // 21: imrenagicom.demoapp.course.v1.BookingService.ReserveBooking:output_type -> imrenagicom.demoapp.course.v1.ReserveBookingResponse
+	10, // 22: imrenagicom.demoapp.course.v1.BookingService.ExpireBooking:output_type -> imrenagicom.demoapp.course.v1.ExpireBookingResponse
+	18, // [18:23] is the sub-list for method output_type
+	13, // [13:18] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_pkg_apiclient_course_v1_booking_proto_init() }
@@ -1267,6 +1093,7 @@ func file_pkg_apiclient_course_v1_booking_proto_init() {
 		return
 	}
 	file_pkg_apiclient_course_v1_catalog_proto_init()
+	file_pkg_apiclient_course_v1_customer_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_pkg_apiclient_course_v1_booking_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Booking); i {
@@ -1281,30 +1108,6 @@ func file_pkg_apiclient_course_v1_booking_proto_init() {
 			}
 		}
 		file_pkg_apiclient_course_v1_booking_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Address); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_apiclient_course_v1_booking_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Customer); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_apiclient_course_v1_booking_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Payment); i {
 			case 0:
 				return &v.state
@@ -1316,7 +1119,7 @@ func file_pkg_apiclient_course_v1_booking_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_apiclient_course_v1_booking_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_apiclient_course_v1_booking_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateBookingRequest); i {
 			case 0:
 				return &v.state
@@ -1328,7 +1131,7 @@ func file_pkg_apiclient_course_v1_booking_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_apiclient_course_v1_booking_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_apiclient_course_v1_booking_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetBookingRequest); i {
 			case 0:
 				return &v.state
@@ -1340,7 +1143,7 @@ func file_pkg_apiclient_course_v1_booking_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_apiclient_course_v1_booking_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_apiclient_course_v1_booking_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReserveBookingRequest); i {
 			case 0:
 				return &v.state
@@ -1352,7 +1155,7 @@ func file_pkg_apiclient_course_v1_booking_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_apiclient_course_v1_booking_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_apiclient_course_v1_booking_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReserveBookingResponse); i {
 			case 0:
 				return &v.state
@@ -1364,7 +1167,7 @@ func file_pkg_apiclient_course_v1_booking_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_apiclient_course_v1_booking_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_apiclient_course_v1_booking_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetPaymentDetailRequest); i {
 			case 0:
 				return &v.state
@@ -1376,7 +1179,7 @@ func file_pkg_apiclient_course_v1_booking_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_apiclient_course_v1_booking_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_apiclient_course_v1_booking_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetPaymentDetailResponse); i {
 			case 0:
 				return &v.state
@@ -1388,7 +1191,7 @@ func file_pkg_apiclient_course_v1_booking_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_apiclient_course_v1_booking_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_apiclient_course_v1_booking_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExpireBookingRequest); i {
 			case 0:
 				return &v.state
@@ -1400,7 +1203,7 @@ func file_pkg_apiclient_course_v1_booking_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_apiclient_course_v1_booking_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_apiclient_course_v1_booking_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExpireBookingResponse); i {
 			case 0:
 				return &v.state
@@ -1412,7 +1215,7 @@ func file_pkg_apiclient_course_v1_booking_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_apiclient_course_v1_booking_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_apiclient_course_v1_booking_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListBookingsRequest); i {
 			case 0:
 				return &v.state
@@ -1424,7 +1227,7 @@ func file_pkg_apiclient_course_v1_booking_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_apiclient_course_v1_booking_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_apiclient_course_v1_booking_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListBookingsResponse); i {
 			case 0:
 				return &v.state
@@ -1443,7 +1246,7 @@ func file_pkg_apiclient_course_v1_booking_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_apiclient_course_v1_booking_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   14,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
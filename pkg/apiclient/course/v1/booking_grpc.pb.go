@@ -30,7 +30,14 @@ const (
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type BookingServiceClient interface {
+	// ListBookings lists the bookings of the customer with the customer token of
+	// that customer or the admin token. Listing without a customer requires the
+	// admin token.
 	ListBookings(ctx context.Context, in *ListBookingsRequest, opts ...grpc.CallOption) (*ListBookingsResponse, error)
+	// CreateBooking creates a booking of a batch. A customer.customer_id requires
+	// the customer token of that customer or the admin token. Otherwise the
+	// customer is found by email, or created, and the booking keeps the given
+	// customer details.
 	CreateBooking(ctx context.Context, in *CreateBookingRequest, opts ...grpc.CallOption) (*Booking, error)
 	GetBooking(ctx context.Context, in *GetBookingRequest, opts ...grpc.CallOption) (*Booking, error)
 	ReserveBooking(ctx context.Context, in *ReserveBookingRequest, opts ...grpc.CallOption) (*ReserveBookingResponse, error)
@@ -94,7 +101,14 @@ func (c *bookingServiceClient) ExpireBooking(ctx context.Context, in *ExpireBook
 // All implementations must embed UnimplementedBookingServiceServer
 // for forward compatibility
 type BookingServiceServer interface {
+	// ListBookings lists the bookings of the customer with the customer token of
+	// that customer or the admin token. Listing without a customer requires the
+	// admin token.
 	ListBookings(context.Context, *ListBookingsRequest) (*ListBookingsResponse, error)
+	// CreateBooking creates a booking of a batch. A customer.customer_id requires
+	// the customer token of that customer or the admin token. Otherwise the
+	// customer is found by email, or created, and the booking keeps the given
+	// customer details.
 	CreateBooking(context.Context, *CreateBookingRequest) (*Booking, error)
 	GetBooking(context.Context, *GetBookingRequest) (*Booking, error)
 	ReserveBooking(context.Context, *ReserveBookingRequest) (*ReserveBookingResponse, error)
@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -19,8 +20,20 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	CatalogService_ListCourses_FullMethodName = "/imrenagicom.demoapp.course.v1.CatalogService/ListCourses"
-	CatalogService_GetCourse_FullMethodName   = "/imrenagicom.demoapp.course.v1.CatalogService/GetCourse"
+	CatalogService_ListCourses_FullMethodName            = "/imrenagicom.demoapp.course.v1.CatalogService/ListCourses"
+	CatalogService_GetCourse_FullMethodName              = "/imrenagicom.demoapp.course.v1.CatalogService/GetCourse"
+	CatalogService_ListBatches_FullMethodName            = "/imrenagicom.demoapp.course.v1.CatalogService/ListBatches"
+	CatalogService_GetBatch_FullMethodName               = "/imrenagicom.demoapp.course.v1.CatalogService/GetBatch"
+	CatalogService_ListCategories_FullMethodName         = "/imrenagicom.demoapp.course.v1.CatalogService/ListCategories"
+	CatalogService_CreateCategory_FullMethodName         = "/imrenagicom.demoapp.course.v1.CatalogService/CreateCategory"
+	CatalogService_ListTags_FullMethodName               = "/imrenagicom.demoapp.course.v1.CatalogService/ListTags"
+	CatalogService_AssignCourseCategories_FullMethodName = "/imrenagicom.demoapp.course.v1.CatalogService/AssignCourseCategories"
+	CatalogService_ImportCourses_FullMethodName          = "/imrenagicom.demoapp.course.v1.CatalogService/ImportCourses"
+	CatalogService_ExportCourses_FullMethodName          = "/imrenagicom.demoapp.course.v1.CatalogService/ExportCourses"
+	CatalogService_ScheduleCourse_FullMethodName         = "/imrenagicom.demoapp.course.v1.CatalogService/ScheduleCourse"
+	CatalogService_ScheduleBatch_FullMethodName          = "/imrenagicom.demoapp.course.v1.CatalogService/ScheduleBatch"
+	CatalogService_AssignCourseTags_FullMethodName       = "/imrenagicom.demoapp.course.v1.CatalogService/AssignCourseTags"
+	CatalogService_SearchCourses_FullMethodName          = "/imrenagicom.demoapp.course.v1.CatalogService/SearchCourses"
 )
 
 // CatalogServiceClient is the client API for CatalogService service.
@@ -29,6 +42,29 @@ const (
 type CatalogServiceClient interface {
 	ListCourses(ctx context.Context, in *ListCoursesRequest, opts ...grpc.CallOption) (*ListCoursesResponse, error)
 	GetCourse(ctx context.Context, in *GetCourseRequest, opts ...grpc.CallOption) (*Course, error)
+	ListBatches(ctx context.Context, in *ListBatchesRequest, opts ...grpc.CallOption) (*ListBatchesResponse, error)
+	GetBatch(ctx context.Context, in *GetBatchRequest, opts ...grpc.CallOption) (*Batch, error)
+	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+	// CreateCategory creates a category. It requires the admin token.
+	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*Category, error)
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	// AssignCourseCategories replaces the categories of the course. It requires the admin token.
+	AssignCourseCategories(ctx context.Context, in *AssignCourseCategoriesRequest, opts ...grpc.CallOption) (*Course, error)
+	// ImportCourses upserts the streamed courses and their batches by slug.
+	// The first message may carry the options of the import. It requires the admin token.
+	ImportCourses(ctx context.Context, opts ...grpc.CallOption) (CatalogService_ImportCoursesClient, error)
+	// ExportCourses streams all courses with their batches, ordered by slug.
+	// It requires the admin token.
+	ExportCourses(ctx context.Context, in *ExportCoursesRequest, opts ...grpc.CallOption) (CatalogService_ExportCoursesClient, error)
+	// ScheduleCourse sets when the course is published and unpublished. The
+	// status is changed by the scheduler. It requires the admin token.
+	ScheduleCourse(ctx context.Context, in *ScheduleCourseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ScheduleBatch sets when the batch is published and unpublished and when
+	// its sales open and close. It requires the admin token.
+	ScheduleBatch(ctx context.Context, in *ScheduleBatchRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// AssignCourseTags replaces the tags of the course. It requires the admin token.
+	AssignCourseTags(ctx context.Context, in *AssignCourseTagsRequest, opts ...grpc.CallOption) (*Course, error)
+	SearchCourses(ctx context.Context, in *SearchCoursesRequest, opts ...grpc.CallOption) (*SearchCoursesResponse, error)
 }
 
 type catalogServiceClient struct {
@@ -57,12 +93,191 @@ func (c *catalogServiceClient) GetCourse(ctx context.Context, in *GetCourseReque
 	return out, nil
 }
 
+func (c *catalogServiceClient) ListBatches(ctx context.Context, in *ListBatchesRequest, opts ...grpc.CallOption) (*ListBatchesResponse, error) {
+	out := new(ListBatchesResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ListBatches_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetBatch(ctx context.Context, in *GetBatchRequest, opts ...grpc.CallOption) (*Batch, error) {
+	out := new(Batch)
+	err := c.cc.Invoke(ctx, CatalogService_GetBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
+	out := new(ListCategoriesResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ListCategories_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*Category, error) {
+	out := new(Category)
+	err := c.cc.Invoke(ctx, CatalogService_CreateCategory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ListTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) AssignCourseCategories(ctx context.Context, in *AssignCourseCategoriesRequest, opts ...grpc.CallOption) (*Course, error) {
+	out := new(Course)
+	err := c.cc.Invoke(ctx, CatalogService_AssignCourseCategories_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ImportCourses(ctx context.Context, opts ...grpc.CallOption) (CatalogService_ImportCoursesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CatalogService_ServiceDesc.Streams[0], CatalogService_ImportCourses_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &catalogServiceImportCoursesClient{stream}
+	return x, nil
+}
+
+type CatalogService_ImportCoursesClient interface {
+	Send(*ImportCoursesRequest) error
+	CloseAndRecv() (*ImportCoursesResponse, error)
+	grpc.ClientStream
+}
+
+type catalogServiceImportCoursesClient struct {
+	grpc.ClientStream
+}
+
+func (x *catalogServiceImportCoursesClient) Send(m *ImportCoursesRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *catalogServiceImportCoursesClient) CloseAndRecv() (*ImportCoursesResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportCoursesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *catalogServiceClient) ExportCourses(ctx context.Context, in *ExportCoursesRequest, opts ...grpc.CallOption) (CatalogService_ExportCoursesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CatalogService_ServiceDesc.Streams[1], CatalogService_ExportCourses_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &catalogServiceExportCoursesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CatalogService_ExportCoursesClient interface {
+	Recv() (*CourseRecord, error)
+	grpc.ClientStream
+}
+
+type catalogServiceExportCoursesClient struct {
+	grpc.ClientStream
+}
+
+func (x *catalogServiceExportCoursesClient) Recv() (*CourseRecord, error) {
+	m := new(CourseRecord)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *catalogServiceClient) ScheduleCourse(ctx context.Context, in *ScheduleCourseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CatalogService_ScheduleCourse_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ScheduleBatch(ctx context.Context, in *ScheduleBatchRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CatalogService_ScheduleBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) AssignCourseTags(ctx context.Context, in *AssignCourseTagsRequest, opts ...grpc.CallOption) (*Course, error) {
+	out := new(Course)
+	err := c.cc.Invoke(ctx, CatalogService_AssignCourseTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) SearchCourses(ctx context.Context, in *SearchCoursesRequest, opts ...grpc.CallOption) (*SearchCoursesResponse, error) {
+	out := new(SearchCoursesResponse)
+	err := c.cc.Invoke(ctx, CatalogService_SearchCourses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CatalogServiceServer is the server API for CatalogService service.
 // All implementations must embed UnimplementedCatalogServiceServer
 // for forward compatibility
 type CatalogServiceServer interface {
 	ListCourses(context.Context, *ListCoursesRequest) (*ListCoursesResponse, error)
 	GetCourse(context.Context, *GetCourseRequest) (*Course, error)
+	ListBatches(context.Context, *ListBatchesRequest) (*ListBatchesResponse, error)
+	GetBatch(context.Context, *GetBatchRequest) (*Batch, error)
+	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
+	// CreateCategory creates a category. It requires the admin token.
+	CreateCategory(context.Context, *CreateCategoryRequest) (*Category, error)
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	// AssignCourseCategories replaces the categories of the course. It requires the admin token.
+	AssignCourseCategories(context.Context, *AssignCourseCategoriesRequest) (*Course, error)
+	// ImportCourses upserts the streamed courses and their batches by slug.
+	// The first message may carry the options of the import. It requires the admin token.
+	ImportCourses(CatalogService_ImportCoursesServer) error
+	// ExportCourses streams all courses with their batches, ordered by slug.
+	// It requires the admin token.
+	ExportCourses(*ExportCoursesRequest, CatalogService_ExportCoursesServer) error
+	// ScheduleCourse sets when the course is published and unpublished. The
+	// status is changed by the scheduler. It requires the admin token.
+	ScheduleCourse(context.Context, *ScheduleCourseRequest) (*emptypb.Empty, error)
+	// ScheduleBatch sets when the batch is published and unpublished and when
+	// its sales open and close. It requires the admin token.
+	ScheduleBatch(context.Context, *ScheduleBatchRequest) (*emptypb.Empty, error)
+	// AssignCourseTags replaces the tags of the course. It requires the admin token.
+	AssignCourseTags(context.Context, *AssignCourseTagsRequest) (*Course, error)
+	SearchCourses(context.Context, *SearchCoursesRequest) (*SearchCoursesResponse, error)
 	mustEmbedUnimplementedCatalogServiceServer()
 }
 
@@ -76,6 +291,42 @@ func (UnimplementedCatalogServiceServer) ListCourses(context.Context, *ListCours
 func (UnimplementedCatalogServiceServer) GetCourse(context.Context, *GetCourseRequest) (*Course, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCourse not implemented")
 }
+func (UnimplementedCatalogServiceServer) ListBatches(context.Context, *ListBatchesRequest) (*ListBatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBatches not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetBatch(context.Context, *GetBatchRequest) (*Batch, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBatch not implemented")
+}
+func (UnimplementedCatalogServiceServer) ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (UnimplementedCatalogServiceServer) CreateCategory(context.Context, *CreateCategoryRequest) (*Category, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedCatalogServiceServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedCatalogServiceServer) AssignCourseCategories(context.Context, *AssignCourseCategoriesRequest) (*Course, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignCourseCategories not implemented")
+}
+func (UnimplementedCatalogServiceServer) ImportCourses(CatalogService_ImportCoursesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportCourses not implemented")
+}
+func (UnimplementedCatalogServiceServer) ExportCourses(*ExportCoursesRequest, CatalogService_ExportCoursesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportCourses not implemented")
+}
+func (UnimplementedCatalogServiceServer) ScheduleCourse(context.Context, *ScheduleCourseRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleCourse not implemented")
+}
+func (UnimplementedCatalogServiceServer) ScheduleBatch(context.Context, *ScheduleBatchRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleBatch not implemented")
+}
+func (UnimplementedCatalogServiceServer) AssignCourseTags(context.Context, *AssignCourseTagsRequest) (*Course, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignCourseTags not implemented")
+}
+func (UnimplementedCatalogServiceServer) SearchCourses(context.Context, *SearchCoursesRequest) (*SearchCoursesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchCourses not implemented")
+}
 func (UnimplementedCatalogServiceServer) mustEmbedUnimplementedCatalogServiceServer() {}
 
 // UnsafeCatalogServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -125,6 +376,233 @@ func _CatalogService_GetCourse_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CatalogService_ListBatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ListBatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ListBatches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ListBatches(ctx, req.(*ListBatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetBatch(ctx, req.(*GetBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ListCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ListCategories(ctx, req.(*ListCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_CreateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ListTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_AssignCourseCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignCourseCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).AssignCourseCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_AssignCourseCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).AssignCourseCategories(ctx, req.(*AssignCourseCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ImportCourses_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CatalogServiceServer).ImportCourses(&catalogServiceImportCoursesServer{stream})
+}
+
+type CatalogService_ImportCoursesServer interface {
+	SendAndClose(*ImportCoursesResponse) error
+	Recv() (*ImportCoursesRequest, error)
+	grpc.ServerStream
+}
+
+type catalogServiceImportCoursesServer struct {
+	grpc.ServerStream
+}
+
+func (x *catalogServiceImportCoursesServer) SendAndClose(m *ImportCoursesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *catalogServiceImportCoursesServer) Recv() (*ImportCoursesRequest, error) {
+	m := new(ImportCoursesRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _CatalogService_ExportCourses_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportCoursesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CatalogServiceServer).ExportCourses(m, &catalogServiceExportCoursesServer{stream})
+}
+
+type CatalogService_ExportCoursesServer interface {
+	Send(*CourseRecord) error
+	grpc.ServerStream
+}
+
+type catalogServiceExportCoursesServer struct {
+	grpc.ServerStream
+}
+
+func (x *catalogServiceExportCoursesServer) Send(m *CourseRecord) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CatalogService_ScheduleCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ScheduleCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ScheduleCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ScheduleCourse(ctx, req.(*ScheduleCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ScheduleBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ScheduleBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ScheduleBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ScheduleBatch(ctx, req.(*ScheduleBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_AssignCourseTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignCourseTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).AssignCourseTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_AssignCourseTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).AssignCourseTags(ctx, req.(*AssignCourseTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_SearchCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchCoursesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).SearchCourses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_SearchCourses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).SearchCourses(ctx, req.(*SearchCoursesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CatalogService_ServiceDesc is the grpc.ServiceDesc for CatalogService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -140,7 +618,58 @@ var CatalogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCourse",
 			Handler:    _CatalogService_GetCourse_Handler,
 		},
+		{
+			MethodName: "ListBatches",
+			Handler:    _CatalogService_ListBatches_Handler,
+		},
+		{
+			MethodName: "GetBatch",
+			Handler:    _CatalogService_GetBatch_Handler,
+		},
+		{
+			MethodName: "ListCategories",
+			Handler:    _CatalogService_ListCategories_Handler,
+		},
+		{
+			MethodName: "CreateCategory",
+			Handler:    _CatalogService_CreateCategory_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _CatalogService_ListTags_Handler,
+		},
+		{
+			MethodName: "AssignCourseCategories",
+			Handler:    _CatalogService_AssignCourseCategories_Handler,
+		},
+		{
+			MethodName: "ScheduleCourse",
+			Handler:    _CatalogService_ScheduleCourse_Handler,
+		},
+		{
+			MethodName: "ScheduleBatch",
+			Handler:    _CatalogService_ScheduleBatch_Handler,
+		},
+		{
+			MethodName: "AssignCourseTags",
+			Handler:    _CatalogService_AssignCourseTags_Handler,
+		},
+		{
+			MethodName: "SearchCourses",
+			Handler:    _CatalogService_SearchCourses_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportCourses",
+			Handler:       _CatalogService_ImportCourses_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportCourses",
+			Handler:       _CatalogService_ExportCourses_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/apiclient/course/v1/catalog.proto",
 }
@@ -0,0 +1,344 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: pkg/apiclient/course/v1/instructor.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	InstructorService_CreateInstructor_FullMethodName   = "/imrenagicom.demoapp.course.v1.InstructorService/CreateInstructor"
+	InstructorService_GetInstructor_FullMethodName      = "/imrenagicom.demoapp.course.v1.InstructorService/GetInstructor"
+	InstructorService_ListInstructors_FullMethodName    = "/imrenagicom.demoapp.course.v1.InstructorService/ListInstructors"
+	InstructorService_UpdateInstructor_FullMethodName   = "/imrenagicom.demoapp.course.v1.InstructorService/UpdateInstructor"
+	InstructorService_DeleteInstructor_FullMethodName   = "/imrenagicom.demoapp.course.v1.InstructorService/DeleteInstructor"
+	InstructorService_AssignInstructor_FullMethodName   = "/imrenagicom.demoapp.course.v1.InstructorService/AssignInstructor"
+	InstructorService_UnassignInstructor_FullMethodName = "/imrenagicom.demoapp.course.v1.InstructorService/UnassignInstructor"
+)
+
+// InstructorServiceClient is the client API for InstructorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type InstructorServiceClient interface {
+	// CreateInstructor requires the admin token.
+	CreateInstructor(ctx context.Context, in *CreateInstructorRequest, opts ...grpc.CallOption) (*Instructor, error)
+	GetInstructor(ctx context.Context, in *GetInstructorRequest, opts ...grpc.CallOption) (*Instructor, error)
+	ListInstructors(ctx context.Context, in *ListInstructorsRequest, opts ...grpc.CallOption) (*ListInstructorsResponse, error)
+	// UpdateInstructor requires the admin token.
+	UpdateInstructor(ctx context.Context, in *UpdateInstructorRequest, opts ...grpc.CallOption) (*Instructor, error)
+	// DeleteInstructor requires the admin token.
+	DeleteInstructor(ctx context.Context, in *DeleteInstructorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// AssignInstructor links the instructor to a course or to a batch of the course.
+	// It requires the admin token.
+	AssignInstructor(ctx context.Context, in *AssignInstructorRequest, opts ...grpc.CallOption) (*Instructor, error)
+	// UnassignInstructor requires the admin token.
+	UnassignInstructor(ctx context.Context, in *UnassignInstructorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type instructorServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInstructorServiceClient(cc grpc.ClientConnInterface) InstructorServiceClient {
+	return &instructorServiceClient{cc}
+}
+
+func (c *instructorServiceClient) CreateInstructor(ctx context.Context, in *CreateInstructorRequest, opts ...grpc.CallOption) (*Instructor, error) {
+	out := new(Instructor)
+	err := c.cc.Invoke(ctx, InstructorService_CreateInstructor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instructorServiceClient) GetInstructor(ctx context.Context, in *GetInstructorRequest, opts ...grpc.CallOption) (*Instructor, error) {
+	out := new(Instructor)
+	err := c.cc.Invoke(ctx, InstructorService_GetInstructor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instructorServiceClient) ListInstructors(ctx context.Context, in *ListInstructorsRequest, opts ...grpc.CallOption) (*ListInstructorsResponse, error) {
+	out := new(ListInstructorsResponse)
+	err := c.cc.Invoke(ctx, InstructorService_ListInstructors_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instructorServiceClient) UpdateInstructor(ctx context.Context, in *UpdateInstructorRequest, opts ...grpc.CallOption) (*Instructor, error) {
+	out := new(Instructor)
+	err := c.cc.Invoke(ctx, InstructorService_UpdateInstructor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instructorServiceClient) DeleteInstructor(ctx context.Context, in *DeleteInstructorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, InstructorService_DeleteInstructor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instructorServiceClient) AssignInstructor(ctx context.Context, in *AssignInstructorRequest, opts ...grpc.CallOption) (*Instructor, error) {
+	out := new(Instructor)
+	err := c.cc.Invoke(ctx, InstructorService_AssignInstructor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instructorServiceClient) UnassignInstructor(ctx context.Context, in *UnassignInstructorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, InstructorService_UnassignInstructor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InstructorServiceServer is the server API for InstructorService service.
+// All implementations must embed UnimplementedInstructorServiceServer
+// for forward compatibility
+type InstructorServiceServer interface {
+	// CreateInstructor requires the admin token.
+	CreateInstructor(context.Context, *CreateInstructorRequest) (*Instructor, error)
+	GetInstructor(context.Context, *GetInstructorRequest) (*Instructor, error)
+	ListInstructors(context.Context, *ListInstructorsRequest) (*ListInstructorsResponse, error)
+	// UpdateInstructor requires the admin token.
+	UpdateInstructor(context.Context, *UpdateInstructorRequest) (*Instructor, error)
+	// DeleteInstructor requires the admin token.
+	DeleteInstructor(context.Context, *DeleteInstructorRequest) (*emptypb.Empty, error)
+	// AssignInstructor links the instructor to a course or to a batch of the course.
+	// It requires the admin token.
+	AssignInstructor(context.Context, *AssignInstructorRequest) (*Instructor, error)
+	// UnassignInstructor requires the admin token.
+	UnassignInstructor(context.Context, *UnassignInstructorRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedInstructorServiceServer()
+}
+
+// UnimplementedInstructorServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedInstructorServiceServer struct {
+}
+
+func (UnimplementedInstructorServiceServer) CreateInstructor(context.Context, *CreateInstructorRequest) (*Instructor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInstructor not implemented")
+}
+func (UnimplementedInstructorServiceServer) GetInstructor(context.Context, *GetInstructorRequest) (*Instructor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstructor not implemented")
+}
+func (UnimplementedInstructorServiceServer) ListInstructors(context.Context, *ListInstructorsRequest) (*ListInstructorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInstructors not implemented")
+}
+func (UnimplementedInstructorServiceServer) UpdateInstructor(context.Context, *UpdateInstructorRequest) (*Instructor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInstructor not implemented")
+}
+func (UnimplementedInstructorServiceServer) DeleteInstructor(context.Context, *DeleteInstructorRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteInstructor not implemented")
+}
+func (UnimplementedInstructorServiceServer) AssignInstructor(context.Context, *AssignInstructorRequest) (*Instructor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignInstructor not implemented")
+}
+func (UnimplementedInstructorServiceServer) UnassignInstructor(context.Context, *UnassignInstructorRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnassignInstructor not implemented")
+}
+func (UnimplementedInstructorServiceServer) mustEmbedUnimplementedInstructorServiceServer() {}
+
+// UnsafeInstructorServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InstructorServiceServer will
+// result in compilation errors.
+type UnsafeInstructorServiceServer interface {
+	mustEmbedUnimplementedInstructorServiceServer()
+}
+
+func RegisterInstructorServiceServer(s grpc.ServiceRegistrar, srv InstructorServiceServer) {
+	s.RegisterService(&InstructorService_ServiceDesc, srv)
+}
+
+func _InstructorService_CreateInstructor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInstructorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstructorServiceServer).CreateInstructor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstructorService_CreateInstructor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstructorServiceServer).CreateInstructor(ctx, req.(*CreateInstructorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstructorService_GetInstructor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstructorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstructorServiceServer).GetInstructor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstructorService_GetInstructor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstructorServiceServer).GetInstructor(ctx, req.(*GetInstructorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstructorService_ListInstructors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInstructorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstructorServiceServer).ListInstructors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstructorService_ListInstructors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstructorServiceServer).ListInstructors(ctx, req.(*ListInstructorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstructorService_UpdateInstructor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateInstructorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstructorServiceServer).UpdateInstructor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstructorService_UpdateInstructor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstructorServiceServer).UpdateInstructor(ctx, req.(*UpdateInstructorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstructorService_DeleteInstructor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteInstructorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstructorServiceServer).DeleteInstructor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstructorService_DeleteInstructor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstructorServiceServer).DeleteInstructor(ctx, req.(*DeleteInstructorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstructorService_AssignInstructor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignInstructorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstructorServiceServer).AssignInstructor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstructorService_AssignInstructor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstructorServiceServer).AssignInstructor(ctx, req.(*AssignInstructorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstructorService_UnassignInstructor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnassignInstructorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstructorServiceServer).UnassignInstructor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstructorService_UnassignInstructor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstructorServiceServer).UnassignInstructor(ctx, req.(*UnassignInstructorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// InstructorService_ServiceDesc is the grpc.ServiceDesc for InstructorService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var InstructorService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "imrenagicom.demoapp.course.v1.InstructorService",
+	HandlerType: (*InstructorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateInstructor",
+			Handler:    _InstructorService_CreateInstructor_Handler,
+		},
+		{
+			MethodName: "GetInstructor",
+			Handler:    _InstructorService_GetInstructor_Handler,
+		},
+		{
+			MethodName: "ListInstructors",
+			Handler:    _InstructorService_ListInstructors_Handler,
+		},
+		{
+			MethodName: "UpdateInstructor",
+			Handler:    _InstructorService_UpdateInstructor_Handler,
+		},
+		{
+			MethodName: "DeleteInstructor",
+			Handler:    _InstructorService_DeleteInstructor_Handler,
+		},
+		{
+			MethodName: "AssignInstructor",
+			Handler:    _InstructorService_AssignInstructor_Handler,
+		},
+		{
+			MethodName: "UnassignInstructor",
+			Handler:    _InstructorService_UnassignInstructor_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/apiclient/course/v1/instructor.proto",
+}
@@ -0,0 +1,47 @@
+package demoapp
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// Version information set by link flags during build. We fall back to these sane
+// default values when we build outside the Makefile context (e.g. go run, go build, or go test).
+var (
+	version   = "99.99.99"             // value from VERSION file
+	buildDate = "1970-01-01T00:00:00Z" // output from `date -u +'%Y-%m-%dT%H:%M:%SZ'`
+	gitCommit = ""                     // output from `git rev-parse HEAD`
+	gitTag    = ""                     // output from `git describe --exact-match --tags HEAD` (if clean tree state)
+)
+
+// Version contains the build information of the binary.
+type Version struct {
+	Version   string `json:"version"`
+	BuildDate string `json:"build_date"`
+	GitCommit string `json:"git_commit,omitempty"`
+	GitTag    string `json:"git_tag,omitempty"`
+	GoVersion string `json:"go_version"`
+	Compiler  string `json:"compiler"`
+	Platform  string `json:"platform"`
+}
+
+func (v Version) String() string {
+	return v.Version
+}
+
+// GetVersion returns the version information of the binary.
+func GetVersion() Version {
+	versionStr := "v" + version
+	if gitTag != "" {
+		versionStr = gitTag
+	}
+	return Version{
+		Version:   versionStr,
+		BuildDate: buildDate,
+		GitCommit: gitCommit,
+		GitTag:    gitTag,
+		GoVersion: runtime.Version(),
+		Compiler:  runtime.Compiler,
+		Platform:  fmt.Sprintf("%s/%s", runtime.GOOS, runtime.GOARCH),
+	}
+}